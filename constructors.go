@@ -15,7 +15,7 @@ func Some[T any](v T) Option[T] {
 //
 //	o := None[int]()
 func None[T any]() Option[T] {
-	return Option[T]{ok: false}
+	return Option[T]{src: newNoneSource()}
 }
 
 // FromPtr returns Some(*p) if p is non-nil, otherwise None.
@@ -27,7 +27,7 @@ func None[T any]() Option[T] {
 //	o := FromPtr[int](nil)  // None[int]()
 func FromPtr[T any](p *T) Option[T] {
 	if p == nil {
-		return Option[T]{ok: false}
+		return None[T]()
 	}
 	return Option[T]{value: *p, ok: true}
 }
@@ -41,7 +41,7 @@ func FromPtr[T any](p *T) Option[T] {
 //	o := FromTuple(v, ok)
 func FromTuple[T any](v T, ok bool) Option[T] {
 	if !ok {
-		return Option[T]{ok: false}
+		return None[T]()
 	}
 	return Option[T]{value: v, ok: true}
 }
@@ -55,21 +55,34 @@ func FromTuple[T any](v T, ok bool) Option[T] {
 //	o := Try(n, err)
 func Try[T any](v T, err error) Option[T] {
 	if err != nil {
-		return Option[T]{ok: false}
+		return None[T]()
 	}
 	return Option[T]{value: v, ok: true}
 }
 
 // Cond returns Some(v) if ok is true, otherwise None[T]().
-// Note: v is always evaluated; for lazy evaluation when v may panic (e.g. *ptr when ptr is nil),
-// use a conditional or FromPtr instead.
+// Note: v is always evaluated; when v is expensive or may panic (e.g. *ptr when ptr is nil),
+// use CondFunc or FromPtr instead.
 //
 // Example:
 //
 //	o := Cond(len(s) > 0, s[0])
 func Cond[T any](ok bool, v T) Option[T] {
 	if !ok {
-		return Option[T]{ok: false}
+		return None[T]()
 	}
 	return Option[T]{value: v, ok: true}
 }
+
+// CondFunc returns Some(fn()) if ok is true, otherwise None[T](). Unlike Cond, fn is only
+// called when ok is true.
+//
+// Example:
+//
+//	o := CondFunc(ptr != nil, func() int { return *ptr })
+func CondFunc[T any](ok bool, fn func() T) Option[T] {
+	if !ok {
+		return None[T]()
+	}
+	return Option[T]{value: fn(), ok: true}
+}
@@ -0,0 +1,129 @@
+package container
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kxrxh/gopt"
+)
+
+// BlockingQueue is an unbounded FIFO queue safe for concurrent use, whose Dequeue waits for
+// an element. After Close, Dequeue still returns the remaining elements and then None.
+// Create with NewBlockingQueue.
+//
+// Example:
+//
+//	jobs := NewBlockingQueue[Job]()
+//	go func() {
+//		for job := jobs.Dequeue(ctx); job.IsSome(); job = jobs.Dequeue(ctx) {
+//			run(job.Unwrap())
+//		}
+//	}()
+//	jobs.Enqueue(j)
+type BlockingQueue[T any] struct {
+	mu     sync.Mutex
+	items  Deque[T]
+	ready  chan struct{} // holds a token while items may be non-empty
+	closed chan struct{}
+}
+
+// NewBlockingQueue returns an empty, open BlockingQueue.
+//
+// Example:
+//
+//	q := NewBlockingQueue[string]()
+func NewBlockingQueue[T any]() *BlockingQueue[T] {
+	return &BlockingQueue[T]{
+		ready:  make(chan struct{}, 1),
+		closed: make(chan struct{}),
+	}
+}
+
+// Enqueue adds v to the back and reports whether it was added; it returns false after Close.
+//
+// Example:
+//
+//	if !q.Enqueue(job) { /* shutting down */ }
+func (q *BlockingQueue[T]) Enqueue(v T) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	select {
+	case <-q.closed:
+		return false
+	default:
+	}
+	q.items.PushBack(v)
+	q.signal()
+	return true
+}
+
+// Dequeue removes and returns the front element, waiting until one is available. It returns
+// None if ctx is done first, or once q is closed and empty.
+//
+// Example:
+//
+//	job := q.Dequeue(ctx)
+func (q *BlockingQueue[T]) Dequeue(ctx context.Context) gopt.Option[T] {
+	for {
+		if v := q.TryDequeue(); v.IsSome() {
+			return v
+		}
+		select {
+		case <-q.ready:
+		case <-q.closed:
+			return q.TryDequeue()
+		case <-ctx.Done():
+			return gopt.None[T]()
+		}
+	}
+}
+
+// TryDequeue removes and returns the front element without waiting, or None if q is empty.
+//
+// Example:
+//
+//	if job, ok := q.TryDequeue().Get(); ok { run(job) }
+func (q *BlockingQueue[T]) TryDequeue() gopt.Option[T] {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	v := q.items.PopFront()
+	if q.items.Len() > 0 {
+		q.signal() // pass the token on to the next waiter
+	}
+	return v
+}
+
+// Len returns the number of queued elements.
+//
+// Example:
+//
+//	backlog := q.Len()
+func (q *BlockingQueue[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.items.Len()
+}
+
+// Close stops q from accepting elements and wakes all waiting Dequeue calls; they return
+// any remaining elements and then None. Calling Close more than once has no effect.
+//
+// Example:
+//
+//	defer q.Close()
+func (q *BlockingQueue[T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	select {
+	case <-q.closed:
+	default:
+		close(q.closed)
+	}
+}
+
+// signal leaves a wake-up token for one waiting Dequeue; q.mu must be held.
+func (q *BlockingQueue[T]) signal() {
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,81 @@
+package container
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBlockingQueue(t *testing.T) {
+	ctx := context.Background()
+	q := NewBlockingQueue[int]()
+	if q.TryDequeue().IsSome() {
+		t.Fatal("TryDequeue() on an empty queue should be None")
+	}
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		q.Enqueue(1)
+	}()
+	if v := q.Dequeue(ctx); v.UnwrapOr(0) != 1 {
+		t.Fatalf("Dequeue() = %v; want Some(1)", v)
+	}
+
+	cctx, cancel := context.WithTimeout(ctx, 5*time.Millisecond)
+	defer cancel()
+	if q.Dequeue(cctx).IsSome() {
+		t.Fatal("Dequeue() should be None when the context is done")
+	}
+
+	q.Enqueue(2)
+	q.Enqueue(3)
+	q.Close()
+	q.Close()
+	if q.Enqueue(4) {
+		t.Fatal("Enqueue() after Close should fail")
+	}
+	if q.Len() != 2 || q.Dequeue(ctx).UnwrapOr(0) != 2 || q.Dequeue(ctx).UnwrapOr(0) != 3 {
+		t.Fatal("Dequeue() after Close should return the remaining elements")
+	}
+	if q.Dequeue(ctx).IsSome() {
+		t.Fatal("Dequeue() on a closed, empty queue should be None")
+	}
+}
+
+func TestBlockingQueueConcurrent(t *testing.T) {
+	ctx := context.Background()
+	q := NewBlockingQueue[int]()
+	const producers, perProducer = 4, 250
+	var got sync.Map
+	var consumers sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		consumers.Add(1)
+		go func() {
+			defer consumers.Done()
+			for v := q.Dequeue(ctx); v.IsSome(); v = q.Dequeue(ctx) {
+				if _, dup := got.LoadOrStore(v.Unwrap(), true); dup {
+					t.Errorf("value %d dequeued twice", v.Unwrap())
+				}
+			}
+		}()
+	}
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		p := p
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				q.Enqueue(p*perProducer + i)
+			}
+		}()
+	}
+	wg.Wait()
+	q.Close()
+	consumers.Wait()
+	n := 0
+	got.Range(func(any, any) bool { n++; return true })
+	if n != producers*perProducer {
+		t.Fatalf("dequeued %d distinct values; want %d", n, producers*perProducer)
+	}
+}
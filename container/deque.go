@@ -0,0 +1,133 @@
+package container
+
+import "github.com/kxrxh/gopt"
+
+// Deque is a double-ended queue backed by a growable ring buffer. The zero value is an
+// empty deque ready to use.
+//
+// Example:
+//
+//	var d Deque[int]
+//	d.PushBack(1)
+//	d.PushFront(0)
+//	d.PopBack()   // Some(1)
+//	d.PopFront()  // Some(0)
+//	d.PopFront()  // None
+type Deque[T any] struct {
+	buf  []T
+	head int // index of the front element in buf
+	n    int
+}
+
+// Len returns the number of elements.
+//
+// Example:
+//
+//	d.Len()  // 2
+func (d *Deque[T]) Len() int {
+	return d.n
+}
+
+// PushBack adds v at the back.
+//
+// Example:
+//
+//	d.PushBack(1)
+func (d *Deque[T]) PushBack(v T) {
+	d.grow()
+	d.buf[(d.head+d.n)%len(d.buf)] = v
+	d.n++
+}
+
+// PushFront adds v at the front.
+//
+// Example:
+//
+//	d.PushFront(0)
+func (d *Deque[T]) PushFront(v T) {
+	d.grow()
+	d.head = (d.head - 1 + len(d.buf)) % len(d.buf)
+	d.buf[d.head] = v
+	d.n++
+}
+
+// PopFront removes and returns the front element, or None if d is empty.
+//
+// Example:
+//
+//	v := d.PopFront()
+func (d *Deque[T]) PopFront() gopt.Option[T] {
+	if d.n == 0 {
+		return gopt.None[T]()
+	}
+	var zero T
+	v := d.buf[d.head]
+	d.buf[d.head] = zero // drop the reference for the GC
+	d.head = (d.head + 1) % len(d.buf)
+	d.n--
+	return gopt.Some(v)
+}
+
+// PopBack removes and returns the back element, or None if d is empty.
+//
+// Example:
+//
+//	v := d.PopBack()
+func (d *Deque[T]) PopBack() gopt.Option[T] {
+	if d.n == 0 {
+		return gopt.None[T]()
+	}
+	var zero T
+	i := (d.head + d.n - 1) % len(d.buf)
+	v := d.buf[i]
+	d.buf[i] = zero
+	d.n--
+	return gopt.Some(v)
+}
+
+// PeekFront returns the front element without removing it, or None if d is empty.
+//
+// Example:
+//
+//	next := d.PeekFront()
+func (d *Deque[T]) PeekFront() gopt.Option[T] {
+	if d.n == 0 {
+		return gopt.None[T]()
+	}
+	return gopt.Some(d.buf[d.head])
+}
+
+// PeekBack returns the back element without removing it, or None if d is empty.
+//
+// Example:
+//
+//	last := d.PeekBack()
+func (d *Deque[T]) PeekBack() gopt.Option[T] {
+	if d.n == 0 {
+		return gopt.None[T]()
+	}
+	return gopt.Some(d.buf[(d.head+d.n-1)%len(d.buf)])
+}
+
+// At returns the element at index i counted from the front, or None if i is out of range.
+//
+// Example:
+//
+//	second := d.At(1)
+func (d *Deque[T]) At(i int) gopt.Option[T] {
+	if i < 0 || i >= d.n {
+		return gopt.None[T]()
+	}
+	return gopt.Some(d.buf[(d.head+i)%len(d.buf)])
+}
+
+// grow makes room for one more element, doubling the buffer when it is full.
+func (d *Deque[T]) grow() {
+	if d.n < len(d.buf) {
+		return
+	}
+	buf := make([]T, max(2*len(d.buf), 8))
+	k := copy(buf, d.buf[d.head:])
+	copy(buf[k:], d.buf[:d.head])
+	d.buf, d.head = buf, 0
+}
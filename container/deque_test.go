@@ -0,0 +1,39 @@
+package container
+
+import "testing"
+
+func TestDeque(t *testing.T) {
+	var d Deque[int]
+	if d.PopFront().IsSome() || d.PopBack().IsSome() || d.PeekFront().IsSome() || d.PeekBack().IsSome() {
+		t.Fatal("empty Deque should return None")
+	}
+	for i := 0; i < 20; i++ { // crosses several grow and wrap-around boundaries
+		d.PushBack(i)
+		d.PushFront(-i - 1)
+	}
+	if d.Len() != 40 {
+		t.Fatalf("Len() = %d; want 40", d.Len())
+	}
+	if v := d.PeekFront(); v.UnwrapOr(0) != -20 {
+		t.Fatalf("PeekFront() = %v; want Some(-20)", v)
+	}
+	if v := d.PeekBack(); v.UnwrapOr(0) != 19 {
+		t.Fatalf("PeekBack() = %v; want Some(19)", v)
+	}
+	if v := d.At(20); v.UnwrapOr(-1) != 0 || d.At(40).IsSome() || d.At(-1).IsSome() {
+		t.Fatalf("At(20) = %v; want Some(0), and None out of range", v)
+	}
+	for i := 19; i >= 0; i-- {
+		if v := d.PopBack(); v.UnwrapOr(-1) != i {
+			t.Fatalf("PopBack() = %v; want Some(%d)", v, i)
+		}
+	}
+	for i := -20; i < 0; i++ {
+		if v := d.PopFront(); v.UnwrapOr(0) != i {
+			t.Fatalf("PopFront() = %v; want Some(%d)", v, i)
+		}
+	}
+	if d.Len() != 0 || d.PopFront().IsSome() {
+		t.Fatal("Deque should be empty")
+	}
+}
@@ -0,0 +1,6 @@
+// Package container provides generic Stack, Queue, Deque, PriorityQueue, Ring and
+// BlockingQueue types whose removal and inspection methods return gopt.Option instead of
+// panicking on an empty container or using the comma-ok form.
+//
+// Except for BlockingQueue, the types are not safe for concurrent use.
+package container
@@ -0,0 +1,102 @@
+package container
+
+import (
+	"cmp"
+	"container/heap"
+
+	"github.com/kxrxh/gopt"
+)
+
+// PriorityQueue is a binary heap: Pop returns the element that sorts first according to its
+// less function. Create with NewPriorityQueue or NewMinQueue.
+//
+// Example:
+//
+//	pq := NewPriorityQueue(func(a, b Task) bool { return a.Deadline.Before(b.Deadline) })
+//	pq.Push(t1, t2)
+//	next := pq.Pop()  // the task with the earliest deadline
+type PriorityQueue[T any] struct {
+	h priorityHeap[T]
+}
+
+// NewPriorityQueue returns an empty PriorityQueue ordered by less.
+//
+// Example:
+//
+//	maxFirst := NewPriorityQueue(func(a, b int) bool { return a > b })
+func NewPriorityQueue[T any](less func(a, b T) bool) *PriorityQueue[T] {
+	return &PriorityQueue[T]{h: priorityHeap[T]{less: less}}
+}
+
+// NewMinQueue returns an empty PriorityQueue that pops the smallest element first.
+//
+// Example:
+//
+//	pq := NewMinQueue[int]()
+func NewMinQueue[T cmp.Ordered]() *PriorityQueue[T] {
+	return NewPriorityQueue(cmp.Less[T])
+}
+
+// Len returns the number of elements.
+//
+// Example:
+//
+//	pq.Len()  // 2
+func (pq *PriorityQueue[T]) Len() int {
+	return len(pq.h.items)
+}
+
+// Push adds vs.
+//
+// Example:
+//
+//	pq.Push(3, 1, 2)
+func (pq *PriorityQueue[T]) Push(vs ...T) {
+	for _, v := range vs {
+		heap.Push(&pq.h, v)
+	}
+}
+
+// Pop removes and returns the first element, or None if pq is empty.
+//
+// Example:
+//
+//	next := pq.Pop()
+func (pq *PriorityQueue[T]) Pop() gopt.Option[T] {
+	if len(pq.h.items) == 0 {
+		return gopt.None[T]()
+	}
+	return gopt.Some(heap.Pop(&pq.h).(T))
+}
+
+// Peek returns the first element without removing it, or None if pq is empty.
+//
+// Example:
+//
+//	next := pq.Peek()
+func (pq *PriorityQueue[T]) Peek() gopt.Option[T] {
+	if len(pq.h.items) == 0 {
+		return gopt.None[T]()
+	}
+	return gopt.Some(pq.h.items[0])
+}
+
+// priorityHeap implements heap.Interface.
+type priorityHeap[T any] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+func (h *priorityHeap[T]) Len() int           { return len(h.items) }
+func (h *priorityHeap[T]) Less(i, j int) bool { return h.less(h.items[i], h.items[j]) }
+func (h *priorityHeap[T]) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *priorityHeap[T]) Push(x any)         { h.items = append(h.items, x.(T)) }
+
+func (h *priorityHeap[T]) Pop() any {
+	var zero T
+	n := len(h.items) - 1
+	v := h.items[n]
+	h.items[n] = zero // drop the reference for the GC
+	h.items = h.items[:n]
+	return v
+}
@@ -0,0 +1,29 @@
+package container
+
+import "testing"
+
+func TestPriorityQueue(t *testing.T) {
+	pq := NewMinQueue[int]()
+	if pq.Pop().IsSome() || pq.Peek().IsSome() {
+		t.Fatal("empty PriorityQueue should return None")
+	}
+	pq.Push(5, 1, 4, 2, 3)
+	if v := pq.Peek(); v.UnwrapOr(0) != 1 || pq.Len() != 5 {
+		t.Fatalf("Peek() = %v, Len() = %d; want Some(1), 5", v, pq.Len())
+	}
+	for want := 1; want <= 5; want++ {
+		if v := pq.Pop(); v.UnwrapOr(0) != want {
+			t.Fatalf("Pop() = %v; want Some(%d)", v, want)
+		}
+	}
+
+	type task struct {
+		name string
+		prio int
+	}
+	maxFirst := NewPriorityQueue(func(a, b task) bool { return a.prio > b.prio })
+	maxFirst.Push(task{"low", 1}, task{"high", 9}, task{"mid", 5})
+	if v := maxFirst.Pop(); v.UnwrapOr(task{}).name != "high" {
+		t.Fatalf("Pop() = %v; want the high priority task", v)
+	}
+}
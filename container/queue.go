@@ -0,0 +1,52 @@
+package container
+
+import "github.com/kxrxh/gopt"
+
+// Queue is a first-in, first-out queue. The zero value is an empty queue ready to use.
+//
+// Example:
+//
+//	var q Queue[Job]
+//	q.Push(job)
+//	next := q.Pop()  // Some(job)
+type Queue[T any] struct {
+	d Deque[T]
+}
+
+// Len returns the number of elements.
+//
+// Example:
+//
+//	q.Len()  // 1
+func (q *Queue[T]) Len() int {
+	return q.d.Len()
+}
+
+// Push adds vs to the back, in order.
+//
+// Example:
+//
+//	q.Push(a, b)  // Pop returns a first
+func (q *Queue[T]) Push(vs ...T) {
+	for _, v := range vs {
+		q.d.PushBack(v)
+	}
+}
+
+// Pop removes and returns the front element, or None if q is empty.
+//
+// Example:
+//
+//	next := q.Pop()
+func (q *Queue[T]) Pop() gopt.Option[T] {
+	return q.d.PopFront()
+}
+
+// Peek returns the front element without removing it, or None if q is empty.
+//
+// Example:
+//
+//	next := q.Peek()
+func (q *Queue[T]) Peek() gopt.Option[T] {
+	return q.d.PeekFront()
+}
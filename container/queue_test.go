@@ -0,0 +1,23 @@
+package container
+
+import "testing"
+
+func TestQueue(t *testing.T) {
+	var q Queue[int]
+	if q.Pop().IsSome() || q.Peek().IsSome() {
+		t.Fatal("empty Queue should return None")
+	}
+	q.Push(1, 2)
+	q.Push(3)
+	if v := q.Peek(); v.UnwrapOr(0) != 1 || q.Len() != 3 {
+		t.Fatalf("Peek() = %v, Len() = %d; want Some(1), 3", v, q.Len())
+	}
+	for want := 1; want <= 3; want++ {
+		if v := q.Pop(); v.UnwrapOr(0) != want {
+			t.Fatalf("Pop() = %v; want Some(%d)", v, want)
+		}
+	}
+	if q.Pop().IsSome() {
+		t.Fatal("Pop() on an emptied Queue should be None")
+	}
+}
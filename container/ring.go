@@ -0,0 +1,87 @@
+package container
+
+import "github.com/kxrxh/gopt"
+
+// Ring is a fixed-capacity FIFO buffer: when it is full, Push overwrites the oldest element.
+// Create with NewRing.
+//
+// Example:
+//
+//	recent := NewRing[string](100)
+//	recent.Push(line)  // keeps the last 100 lines
+type Ring[T any] struct {
+	d   Deque[T]
+	cap int
+}
+
+// NewRing returns an empty Ring holding at most capacity elements. It panics if capacity < 1.
+//
+// Example:
+//
+//	r := NewRing[int](3)
+func NewRing[T any](capacity int) *Ring[T] {
+	if capacity < 1 {
+		panic("container: Ring capacity must be at least 1")
+	}
+	return &Ring[T]{cap: capacity}
+}
+
+// Len returns the number of elements.
+//
+// Example:
+//
+//	r.Len()  // 3
+func (r *Ring[T]) Len() int {
+	return r.d.Len()
+}
+
+// Cap returns the capacity.
+//
+// Example:
+//
+//	r.Cap()  // 3
+func (r *Ring[T]) Cap() int {
+	return r.cap
+}
+
+// Push adds v as the newest element. If r was full, the oldest element is removed and
+// returned as Some; otherwise the result is None.
+//
+// Example:
+//
+//	if old, ok := r.Push(v).Get(); ok { flush(old) }
+func (r *Ring[T]) Push(v T) gopt.Option[T] {
+	var evicted gopt.Option[T]
+	if r.d.Len() == r.cap {
+		evicted = r.d.PopFront()
+	}
+	r.d.PushBack(v)
+	return evicted
+}
+
+// Pop removes and returns the oldest element, or None if r is empty.
+//
+// Example:
+//
+//	oldest := r.Pop()
+func (r *Ring[T]) Pop() gopt.Option[T] {
+	return r.d.PopFront()
+}
+
+// Peek returns the oldest element without removing it, or None if r is empty.
+//
+// Example:
+//
+//	oldest := r.Peek()
+func (r *Ring[T]) Peek() gopt.Option[T] {
+	return r.d.PeekFront()
+}
+
+// Newest returns the most recently pushed element, or None if r is empty.
+//
+// Example:
+//
+//	last := r.Newest()
+func (r *Ring[T]) Newest() gopt.Option[T] {
+	return r.d.PeekBack()
+}
@@ -0,0 +1,33 @@
+package container
+
+import "testing"
+
+func TestRing(t *testing.T) {
+	r := NewRing[int](3)
+	if r.Pop().IsSome() || r.Peek().IsSome() || r.Newest().IsSome() {
+		t.Fatal("empty Ring should return None")
+	}
+	for i := 1; i <= 3; i++ {
+		if r.Push(i).IsSome() {
+			t.Fatalf("Push(%d) into a non-full Ring should evict nothing", i)
+		}
+	}
+	if v := r.Push(4); v.UnwrapOr(0) != 1 {
+		t.Fatalf("Push(4) = %v; want Some(1) evicted", v)
+	}
+	if r.Len() != 3 || r.Cap() != 3 || r.Peek().UnwrapOr(0) != 2 || r.Newest().UnwrapOr(0) != 4 {
+		t.Fatalf("Len, Cap, Peek, Newest = %d, %d, %v, %v; want 3, 3, Some(2), Some(4)", r.Len(), r.Cap(), r.Peek(), r.Newest())
+	}
+	if v := r.Pop(); v.UnwrapOr(0) != 2 {
+		t.Fatalf("Pop() = %v; want Some(2)", v)
+	}
+}
+
+func TestNewRingPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("NewRing(0) should panic")
+		}
+	}()
+	NewRing[int](0)
+}
@@ -0,0 +1,61 @@
+package container
+
+import "github.com/kxrxh/gopt"
+
+// Stack is a last-in, first-out stack. The zero value is an empty stack ready to use.
+//
+// Example:
+//
+//	var s Stack[string]
+//	s.Push("a", "b")
+//	s.Pop()  // Some("b")
+type Stack[T any] struct {
+	items []T
+}
+
+// Len returns the number of elements.
+//
+// Example:
+//
+//	s.Len()  // 1
+func (s *Stack[T]) Len() int {
+	return len(s.items)
+}
+
+// Push adds vs to the top, in order, so the last one is on top.
+//
+// Example:
+//
+//	s.Push(1, 2)  // Pop returns 2 first
+func (s *Stack[T]) Push(vs ...T) {
+	s.items = append(s.items, vs...)
+}
+
+// Pop removes and returns the top element, or None if s is empty.
+//
+// Example:
+//
+//	for v := s.Pop(); v.IsSome(); v = s.Pop() { visit(v.Unwrap()) }
+func (s *Stack[T]) Pop() gopt.Option[T] {
+	n := len(s.items)
+	if n == 0 {
+		return gopt.None[T]()
+	}
+	var zero T
+	v := s.items[n-1]
+	s.items[n-1] = zero // drop the reference for the GC
+	s.items = s.items[:n-1]
+	return gopt.Some(v)
+}
+
+// Peek returns the top element without removing it, or None if s is empty.
+//
+// Example:
+//
+//	top := s.Peek()
+func (s *Stack[T]) Peek() gopt.Option[T] {
+	if len(s.items) == 0 {
+		return gopt.None[T]()
+	}
+	return gopt.Some(s.items[len(s.items)-1])
+}
@@ -0,0 +1,23 @@
+package container
+
+import "testing"
+
+func TestStack(t *testing.T) {
+	var s Stack[string]
+	if s.Pop().IsSome() || s.Peek().IsSome() {
+		t.Fatal("empty Stack should return None")
+	}
+	s.Push("a", "b")
+	s.Push("c")
+	if v := s.Peek(); v.UnwrapOr("") != "c" || s.Len() != 3 {
+		t.Fatalf("Peek() = %v, Len() = %d; want Some(c), 3", v, s.Len())
+	}
+	for _, want := range []string{"c", "b", "a"} {
+		if v := s.Pop(); v.UnwrapOr("") != want {
+			t.Fatalf("Pop() = %v; want Some(%s)", v, want)
+		}
+	}
+	if s.Pop().IsSome() {
+		t.Fatal("Pop() on an emptied Stack should be None")
+	}
+}
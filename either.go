@@ -0,0 +1,352 @@
+package gopt
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Either holds exactly one of two values: a Left of type L or a Right of type R.
+// Create with Left or Right; the zero value is Left(zero L).
+//
+// Example:
+//
+//	var id Either[int, string] = Right[int]("usr_42")
+//	if s, ok := id.Right().Get(); ok { fmt.Println(s) }
+type Either[L, R any] struct {
+	left    L
+	right   R
+	isRight bool
+}
+
+// Left returns an Either holding the left value v.
+//
+// Example:
+//
+//	e := Left[int, string](42)
+func Left[L, R any](v L) Either[L, R] {
+	return Either[L, R]{left: v}
+}
+
+// Right returns an Either holding the right value v.
+//
+// Example:
+//
+//	e := Right[int]("x")
+func Right[L, R any](v R) Either[L, R] {
+	return Either[L, R]{right: v, isRight: true}
+}
+
+// IsLeft returns true if e holds a left value.
+//
+// Example:
+//
+//	Left[int, string](1).IsLeft()  // true
+func (e Either[L, R]) IsLeft() bool {
+	return !e.isRight
+}
+
+// IsRight returns true if e holds a right value.
+//
+// Example:
+//
+//	Right[int]("x").IsRight()  // true
+func (e Either[L, R]) IsRight() bool {
+	return e.isRight
+}
+
+// Left returns Some(left value) if e is Left, otherwise None.
+//
+// Example:
+//
+//	Left[int, string](1).Left()  // Some(1)
+//	Right[int]("x").Left()       // None[int]()
+func (e Either[L, R]) Left() Option[L] {
+	return FromTuple(e.left, !e.isRight)
+}
+
+// Right returns Some(right value) if e is Right, otherwise None.
+//
+// Example:
+//
+//	Right[int]("x").Right()  // Some("x")
+func (e Either[L, R]) Right() Option[R] {
+	return FromTuple(e.right, e.isRight)
+}
+
+// Swap turns a Left into a Right and vice versa.
+//
+// Example:
+//
+//	Left[int, string](1).Swap()  // Right[string](1)
+func (e Either[L, R]) Swap() Either[R, L] {
+	return Either[R, L]{left: e.right, right: e.left, isRight: !e.isRight}
+}
+
+// MapLeft applies fn to the left value if e is Left; a Right is passed through.
+//
+// Example:
+//
+//	MapLeft(Left[int, string](2), func(x int) int { return x * 2 })  // Left(4)
+func MapLeft[L, R, L2 any](e Either[L, R], fn func(L) L2) Either[L2, R] {
+	if e.isRight {
+		return Right[L2](e.right)
+	}
+	return Left[L2, R](fn(e.left))
+}
+
+// MapRight applies fn to the right value if e is Right; a Left is passed through.
+//
+// Example:
+//
+//	MapRight(Right[int]("a"), strings.ToUpper)  // Right("A")
+func MapRight[L, R, R2 any](e Either[L, R], fn func(R) R2) Either[L, R2] {
+	if e.isRight {
+		return Right[L](fn(e.right))
+	}
+	return Left[L, R2](e.left)
+}
+
+// FoldEither returns onLeft(left value) if e is Left, otherwise onRight(right value).
+// It is the Either counterpart of Match.
+//
+// Example:
+//
+//	s := FoldEither(id, strconv.Itoa, func(s string) string { return s })
+func FoldEither[L, R, T any](e Either[L, R], onLeft func(L) T, onRight func(R) T) T {
+	if e.isRight {
+		return onRight(e.right)
+	}
+	return onLeft(e.left)
+}
+
+// Partition splits es into its left values and its right values, preserving order.
+//
+// Example:
+//
+//	cached, toFetch := Partition(results)
+func Partition[L, R any](es []Either[L, R]) ([]L, []R) {
+	var lefts []L
+	var rights []R
+	for _, e := range es {
+		if e.isRight {
+			rights = append(rights, e.right)
+		} else {
+			lefts = append(lefts, e.left)
+		}
+	}
+	return lefts, rights
+}
+
+// EitherEncoding describes the JSON shape of an Either.
+//
+// With Tag set, Either is encoded as an object with a discriminator field and a value field:
+//
+//	{"<Tag>": "<Left or Right>", "<Value>": v}
+//
+// With Tag empty, it is encoded as a single-key object named after the side:
+//
+//	{"<Left or Right>": v}
+type EitherEncoding struct {
+	Tag   string // discriminator field name; empty for the single-key form
+	Left  string // name of the left side
+	Right string // name of the right side
+	Value string // value field name; used only when Tag is set
+}
+
+// ErrEitherEncoding is returned by MarshalEither and UnmarshalEither for an EitherEncoding
+// that cannot be decoded unambiguously: Left equal to Right, or Tag equal to Value.
+var ErrEitherEncoding = errors.New("gopt: ambiguous EitherEncoding")
+
+// validate returns ErrEitherEncoding if enc cannot tell the sides or fields apart.
+func (enc EitherEncoding) validate() error {
+	if enc.Left == enc.Right {
+		return fmt.Errorf("%w: Left and Right are both %q", ErrEitherEncoding, enc.Left)
+	}
+	if enc.Tag != "" && enc.Tag == enc.Value {
+		return fmt.Errorf("%w: Tag and Value are both %q", ErrEitherEncoding, enc.Tag)
+	}
+	return nil
+}
+
+// DefaultEitherEncoding returns the encoding used by Either's MarshalJSON and UnmarshalJSON:
+// {"type": "left", "value": v} or {"type": "right", "value": v}.
+// Use MarshalEither and UnmarshalEither, or EitherAs for struct fields, to pick a different encoding.
+//
+// Example:
+//
+//	enc := DefaultEitherEncoding()
+//	enc.Tag = "kind"
+func DefaultEitherEncoding() EitherEncoding {
+	return EitherEncoding{Tag: "type", Left: "left", Right: "right", Value: "value"}
+}
+
+// MarshalEither encodes e as JSON using enc. With a Tag, the discriminator is written
+// first so streaming decoders can pick the side before reading the value.
+// It returns an error wrapping ErrEitherEncoding if enc is ambiguous.
+//
+// Example:
+//
+//	b, _ := MarshalEither(Left[int, string](1), DefaultEitherEncoding())  // {"type":"left","value":1}
+func MarshalEither[L, R any](e Either[L, R], enc EitherEncoding) ([]byte, error) {
+	if err := enc.validate(); err != nil {
+		return nil, err
+	}
+	side := enc.Left
+	var v any = e.left
+	if e.isRight {
+		side, v = enc.Right, e.right
+	}
+	val, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	if enc.Tag == "" {
+		writeJSONKey(&buf, side)
+	} else {
+		writeJSONKey(&buf, enc.Tag)
+		b, _ := json.Marshal(side)
+		buf.Write(b)
+		buf.WriteByte(',')
+		writeJSONKey(&buf, enc.Value)
+	}
+	buf.Write(val)
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// writeJSONKey writes the JSON string k followed by a colon.
+func writeJSONKey(buf *bytes.Buffer, k string) {
+	b, _ := json.Marshal(k)
+	buf.Write(b)
+	buf.WriteByte(':')
+}
+
+// UnmarshalEither decodes JSON produced by MarshalEither with the same enc.
+// It returns an error if the discriminator is missing or names neither side, and an error
+// wrapping ErrEitherEncoding if enc is ambiguous.
+//
+// Example:
+//
+//	e, err := UnmarshalEither[int, string]([]byte(`{"type":"right","value":"x"}`), DefaultEitherEncoding())
+func UnmarshalEither[L, R any](data []byte, enc EitherEncoding) (Either[L, R], error) {
+	if err := enc.validate(); err != nil {
+		return Either[L, R]{}, err
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return Either[L, R]{}, err
+	}
+	var side string
+	var value json.RawMessage
+	if enc.Tag == "" {
+		if len(raw) != 1 {
+			return Either[L, R]{}, fmt.Errorf("gopt: Either object must have exactly one key, got %d", len(raw))
+		}
+		for k, v := range raw {
+			side, value = k, v
+		}
+	} else {
+		tag, ok := raw[enc.Tag]
+		if !ok {
+			return Either[L, R]{}, fmt.Errorf("gopt: Either discriminator %q missing", enc.Tag)
+		}
+		if err := json.Unmarshal(tag, &side); err != nil {
+			return Either[L, R]{}, err
+		}
+		if value = raw[enc.Value]; value == nil {
+			value = json.RawMessage("null")
+		}
+	}
+	switch side {
+	case enc.Left:
+		var l L
+		if err := json.Unmarshal(value, &l); err != nil {
+			return Either[L, R]{}, err
+		}
+		return Left[L, R](l), nil
+	case enc.Right:
+		var r R
+		if err := json.Unmarshal(value, &r); err != nil {
+			return Either[L, R]{}, err
+		}
+		return Right[L](r), nil
+	}
+	return Either[L, R]{}, fmt.Errorf("gopt: unknown Either side %q", side)
+}
+
+// MarshalJSON implements encoding/json.Marshaler using DefaultEitherEncoding.
+//
+// Example:
+//
+//	b, _ := json.Marshal(Right[int]("x"))  // {"type":"right","value":"x"}
+func (e Either[L, R]) MarshalJSON() ([]byte, error) {
+	return MarshalEither(e, DefaultEitherEncoding())
+}
+
+// UnmarshalJSON implements encoding/json.Unmarshaler using DefaultEitherEncoding.
+// JSON null leaves e unchanged, following the encoding/json convention.
+//
+// Example:
+//
+//	var e Either[int, string]
+//	json.Unmarshal([]byte(`{"type":"left","value":1}`), &e)  // e = Left(1)
+func (e *Either[L, R]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+	v, err := UnmarshalEither[L, R](data, DefaultEitherEncoding())
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
+// EitherEncoder supplies the encoding used by EitherAs. Implement it on an empty struct type.
+//
+// Example:
+//
+//	type kindEncoding struct{}
+//	func (kindEncoding) EitherEncoding() EitherEncoding {
+//		return EitherEncoding{Tag: "kind", Left: "cached", Right: "fetch", Value: "data"}
+//	}
+type EitherEncoder interface {
+	EitherEncoding() EitherEncoding
+}
+
+// EitherAs is an Either whose JSON encoding is chosen by E, for struct fields that should not
+// use DefaultEitherEncoding. The Either methods are promoted from the embedded field.
+//
+// Example:
+//
+//	type Result struct{ Source EitherAs[int, string, kindEncoding] }
+//	r := Result{Source: EitherAs[int, string, kindEncoding]{Right[int]("db")}}
+//	b, _ := json.Marshal(r)  // {"Source":{"kind":"fetch","data":"db"}}
+type EitherAs[L, R any, E EitherEncoder] struct {
+	Either[L, R]
+}
+
+// MarshalJSON implements encoding/json.Marshaler using E's encoding.
+func (e EitherAs[L, R, E]) MarshalJSON() ([]byte, error) {
+	var enc E
+	return MarshalEither(e.Either, enc.EitherEncoding())
+}
+
+// UnmarshalJSON implements encoding/json.Unmarshaler using E's encoding.
+// JSON null leaves e unchanged, following the encoding/json convention.
+func (e *EitherAs[L, R, E]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+	var enc E
+	v, err := UnmarshalEither[L, R](data, enc.EitherEncoding())
+	if err != nil {
+		return err
+	}
+	e.Either = v
+	return nil
+}
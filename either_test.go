@@ -0,0 +1,150 @@
+package gopt
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestEither(t *testing.T) {
+	l := Left[int, string](1)
+	r := Right[int]("x")
+	if !l.IsLeft() || l.IsRight() || r.IsLeft() || !r.IsRight() {
+		t.Fatal("IsLeft/IsRight mismatch")
+	}
+	if l.Left().Unwrap() != 1 || l.Right().IsSome() {
+		t.Fatal("Left projections mismatch")
+	}
+	if r.Right().Unwrap() != "x" || r.Left().IsSome() {
+		t.Fatal("Right projections mismatch")
+	}
+	if s := l.Swap(); !s.IsRight() || s.Right().Unwrap() != 1 {
+		t.Fatal("Swap(Left) should be Right")
+	}
+	if s := r.Swap(); !s.IsLeft() || s.Left().Unwrap() != "x" {
+		t.Fatal("Swap(Right) should be Left")
+	}
+	var zero Either[int, string]
+	if !zero.IsLeft() {
+		t.Fatal("zero Either should be Left")
+	}
+}
+
+func TestEitherMapFold(t *testing.T) {
+	double := func(x int) int { return x * 2 }
+	if MapLeft(Left[int, string](2), double).Left().Unwrap() != 4 {
+		t.Fatal("MapLeft on Left should apply fn")
+	}
+	if MapLeft(Right[int]("a"), double).Right().Unwrap() != "a" {
+		t.Fatal("MapLeft on Right should pass through")
+	}
+	if MapRight(Right[int]("a"), strings.ToUpper).Right().Unwrap() != "A" {
+		t.Fatal("MapRight on Right should apply fn")
+	}
+	if MapRight(Left[int, string](2), strings.ToUpper).Left().Unwrap() != 2 {
+		t.Fatal("MapRight on Left should pass through")
+	}
+	id := func(s string) string { return s }
+	if FoldEither(Left[int, string](7), strconv.Itoa, id) != "7" || FoldEither(Right[int]("x"), strconv.Itoa, id) != "x" {
+		t.Fatal("FoldEither mismatch")
+	}
+}
+
+func TestPartition(t *testing.T) {
+	es := []Either[int, string]{Left[int, string](1), Right[int]("a"), Left[int, string](2)}
+	ls, rs := Partition(es)
+	if len(ls) != 2 || ls[0] != 1 || ls[1] != 2 || len(rs) != 1 || rs[0] != "a" {
+		t.Fatalf("Partition = %v, %v; want [1 2], [a]", ls, rs)
+	}
+}
+
+func TestEitherJSON(t *testing.T) {
+	b, err := json.Marshal([]Either[int, string]{Left[int, string](1), Right[int]("x")})
+	if err != nil || string(b) != `[{"type":"left","value":1},{"type":"right","value":"x"}]` {
+		t.Fatalf("json.Marshal = %s, %v", b, err)
+	}
+	var es []Either[int, string]
+	if err := json.Unmarshal(b, &es); err != nil || es[0].Left().Unwrap() != 1 || es[1].Right().Unwrap() != "x" {
+		t.Fatalf("json.Unmarshal = %v, %v", es, err)
+	}
+
+	custom := EitherEncoding{Tag: "kind", Left: "cached", Right: "fetch", Value: "data"}
+	b, err = MarshalEither(Right[int]("u"), custom)
+	if err != nil || string(b) != `{"kind":"fetch","data":"u"}` {
+		t.Fatalf("MarshalEither with custom encoding = %s, %v", b, err)
+	}
+	e, err := UnmarshalEither[int, string]([]byte(`{"data":3,"kind":"cached"}`), custom)
+	if err != nil || e.Left().Unwrap() != 3 {
+		t.Fatalf("UnmarshalEither with custom encoding = %v, %v", e, err)
+	}
+	if _, err := UnmarshalEither[int, string]([]byte(`{"kind":"other","data":3}`), custom); err == nil {
+		t.Fatal("unknown side should return error")
+	}
+	if err := json.Unmarshal([]byte(`{"value":3}`), &e); err == nil {
+		t.Fatal("missing discriminator should return error")
+	}
+	b, err = MarshalEither(Left[int, string](1), EitherEncoding{Tag: `k"`, Left: "a\nb", Value: "v"})
+	if err != nil || string(b) != `{"k\"":"a\nb","v":1}` {
+		t.Fatalf("MarshalEither with escaped names = %s, %v", b, err)
+	}
+
+	single := EitherEncoding{Left: "legacy", Right: "id"}
+	b, err = MarshalEither(Left[int, string](5), single)
+	if err != nil || string(b) != `{"legacy":5}` {
+		t.Fatalf("MarshalEither single-key = %s, %v", b, err)
+	}
+	e, err = UnmarshalEither[int, string]([]byte(`{"id":"u1"}`), single)
+	if err != nil || e.Right().Unwrap() != "u1" {
+		t.Fatalf("UnmarshalEither single-key = %v, %v", e, err)
+	}
+	if _, err := UnmarshalEither[int, string]([]byte(`{"id":"u1","legacy":1}`), single); err == nil {
+		t.Fatal("single-key form with two keys should return error")
+	}
+}
+
+func TestEitherEncodingValidation(t *testing.T) {
+	bad := []EitherEncoding{
+		{Tag: "type", Left: "x", Right: "x", Value: "value"},
+		{Tag: "type", Left: "left", Right: "right", Value: "type"},
+		{},
+	}
+	for _, enc := range bad {
+		if _, err := MarshalEither(Left[int, string](1), enc); !errors.Is(err, ErrEitherEncoding) {
+			t.Fatalf("MarshalEither(%+v) error = %v; want ErrEitherEncoding", enc, err)
+		}
+		if _, err := UnmarshalEither[int, string]([]byte(`{"type":"x","value":1}`), enc); !errors.Is(err, ErrEitherEncoding) {
+			t.Fatalf("UnmarshalEither(%+v) error = %v; want ErrEitherEncoding", enc, err)
+		}
+	}
+}
+
+type kindEncoding struct{}
+
+func (kindEncoding) EitherEncoding() EitherEncoding {
+	return EitherEncoding{Tag: "kind", Left: "cached", Right: "fetch", Value: "data"}
+}
+
+func TestEitherAs(t *testing.T) {
+	type result struct {
+		Source  EitherAs[int, string, kindEncoding] `json:"source"`
+		Default Either[int, string]                 `json:"default"`
+	}
+	r := result{
+		Source:  EitherAs[int, string, kindEncoding]{Right[int]("db")},
+		Default: Left[int, string](1),
+	}
+	b, err := json.Marshal(r)
+	want := `{"source":{"kind":"fetch","data":"db"},"default":{"type":"left","value":1}}`
+	if err != nil || string(b) != want {
+		t.Fatalf("json.Marshal(result) = %s, %v; want %s", b, err, want)
+	}
+	var back result
+	if err := json.Unmarshal(b, &back); err != nil || back.Source.Right().UnwrapOr("") != "db" || back.Default.Left().UnwrapOr(0) != 1 {
+		t.Fatalf("json.Unmarshal(result) = %+v, %v", back, err)
+	}
+	if err := json.Unmarshal([]byte(`{"source":{"type":"left","value":1}}`), &back); err == nil {
+		t.Fatal("EitherAs should reject the default encoding")
+	}
+}
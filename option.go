@@ -14,6 +14,7 @@ package gopt
 //	o := Some(42)
 //	if o.IsSome() { v := o.UnwrapOr(0) }
 type Option[T any] struct {
+	src   noneSource // where a None was created; empty unless built with gopt_debug
 	value T
 	ok    bool
 }
@@ -48,7 +49,7 @@ func (o Option[T]) Get() (T, bool) {
 	return o.value, o.ok
 }
 
-// Unwrap returns the contained value. It panics if the option is None.
+// Unwrap returns the contained value. It panics with an *UnwrapError if the option is None.
 // Prefer UnwrapOr, UnwrapOrElse, or Match when a default or explicit handling is needed.
 //
 // Example:
@@ -57,7 +58,7 @@ func (o Option[T]) Get() (T, bool) {
 //	v := None[int]().Unwrap()  // panics
 func (o Option[T]) Unwrap() T {
 	if !o.ok {
-		panic("gopt: Unwrap called on None")
+		unwrapPanic[T](o.src, "", nil)
 	}
 	return o.value
 }
@@ -87,14 +88,14 @@ func (o Option[T]) UnwrapOrElse(fn func() T) T {
 	return fn()
 }
 
-// Expect returns the contained value if Some. It panics with the given message if None.
+// Expect returns the contained value if Some. It panics with an *UnwrapError carrying msg if None.
 //
 // Example:
 //
 //	v := Some(42).Expect("required")  // v=42
 func (o Option[T]) Expect(msg string) T {
 	if !o.ok {
-		panic("gopt: " + msg)
+		unwrapPanic[T](o.src, msg, nil)
 	}
 	return o.value
 }
@@ -106,7 +107,7 @@ func (o Option[T]) Expect(msg string) T {
 //	Some(4).Filter(func(x int) bool { return x%2 == 0 })  // Some(4)
 //	Some(3).Filter(func(x int) bool { return x%2 == 0 })   // None[int]()
 func (o Option[T]) Filter(pred func(T) bool) Option[T] {
-	if !o.ok || !pred(o.value) {
+	if o.ok && !pred(o.value) {
 		return None[T]()
 	}
 	return o
@@ -165,3 +166,92 @@ func (o Option[T]) ToPointer() *T {
 	*v = o.value
 	return v
 }
+
+// Take returns the current option and leaves None in its place.
+//
+// Example:
+//
+//	o := Some(42)
+//	v := o.Take()  // v=Some(42), o=None[int]()
+func (o *Option[T]) Take() Option[T] {
+	old := *o
+	*o = None[T]()
+	return old
+}
+
+// Replace stores Some(v) and returns the previous option.
+//
+// Example:
+//
+//	o := Some(1)
+//	old := o.Replace(2)  // old=Some(1), o=Some(2)
+func (o *Option[T]) Replace(v T) Option[T] {
+	old := *o
+	*o = Some(v)
+	return old
+}
+
+// Insert stores Some(v), discarding any previous value, and returns a pointer to the stored value.
+// The pointer is valid until the option is next modified.
+//
+// Example:
+//
+//	var o Option[int]
+//	p := o.Insert(5)  // o=Some(5)
+//	*p = 6            // o=Some(6)
+func (o *Option[T]) Insert(v T) *T {
+	*o = Some(v)
+	return &o.value
+}
+
+// GetOrInsert stores Some(v) if the option is None, then returns a pointer to the stored value.
+// The pointer is valid until the option is next modified.
+//
+// Example:
+//
+//	var o Option[int]
+//	*o.GetOrInsert(1) += 10  // o=Some(11)
+func (o *Option[T]) GetOrInsert(v T) *T {
+	if !o.ok {
+		*o = Some(v)
+	}
+	return &o.value
+}
+
+// GetOrInsertWith stores Some(fn()) if the option is None, then returns a pointer to the stored value.
+// fn is only called when the option is None.
+//
+// Example:
+//
+//	var cache Option[map[string]int]
+//	cache.GetOrInsertWith(func() map[string]int { return map[string]int{} })
+func (o *Option[T]) GetOrInsertWith(fn func() T) *T {
+	if !o.ok {
+		*o = Some(fn())
+	}
+	return &o.value
+}
+
+// Clear sets the option to None.
+//
+// Example:
+//
+//	o := Some(42)
+//	o.Clear()  // o=None[int]()
+func (o *Option[T]) Clear() {
+	*o = None[T]()
+}
+
+// Ptr returns a pointer to the stored value if Some, otherwise nil.
+// Unlike ToPointer, no copy is made: writes through the pointer change o.
+//
+// Example:
+//
+//	o := Some(1)
+//	if p := o.Ptr(); p != nil { *p++ }  // o=Some(2)
+func (o *Option[T]) Ptr() *T {
+	if !o.ok {
+		return nil
+	}
+	return &o.value
+}
@@ -0,0 +1,103 @@
+package gopt
+
+import "sync/atomic"
+
+// AtomicOption is an Option[T] that can be read and written by several goroutines without
+// a mutex. It stores a pointer to an immutable copy of the value: None is a nil pointer,
+// so Load never blocks and Store allocates one T. The zero value is None and ready to use.
+// An AtomicOption must not be copied after first use.
+//
+// Example:
+//
+//	var leader AtomicOption[string]
+//	leader.Store(Some("node-2"))
+//	if id, ok := leader.Load().Get(); ok { dial(id) }
+type AtomicOption[T any] struct {
+	p atomic.Pointer[T]
+}
+
+// NewAtomicOption returns an AtomicOption holding o.
+//
+// Example:
+//
+//	reason := NewAtomicOption(None[string]())
+func NewAtomicOption[T any](o Option[T]) *AtomicOption[T] {
+	a := &AtomicOption[T]{}
+	a.Store(o)
+	return a
+}
+
+// Load returns the current option. The value is a copy; see ToPointer for the same caveat.
+//
+// Example:
+//
+//	snap := latest.Load()
+func (a *AtomicOption[T]) Load() Option[T] {
+	return FromPtr(a.p.Load())
+}
+
+// Store sets the option to o.
+//
+// Example:
+//
+//	latest.Store(Some(snapshot))
+func (a *AtomicOption[T]) Store(o Option[T]) {
+	a.p.Store(boxOption(o))
+}
+
+// Swap sets the option to o and returns the previous option.
+//
+// Example:
+//
+//	prev := leader.Swap(Some("node-3"))
+func (a *AtomicOption[T]) Swap(o Option[T]) Option[T] {
+	return FromPtr(a.p.Swap(boxOption(o)))
+}
+
+// Take sets the option to None and returns the previous option, atomically, so exactly one
+// of several concurrent callers receives a given Some.
+//
+// Example:
+//
+//	if reason, ok := shutdown.Take().Get(); ok { log.Print(reason) }
+func (a *AtomicOption[T]) Take() Option[T] {
+	return FromPtr(a.p.Swap(nil))
+}
+
+// StoreIfNone sets the option to Some(v) only if it is None, and reports whether it did.
+// It gives set-once semantics: the first caller wins and later calls leave the value unchanged.
+//
+// Example:
+//
+//	shutdown.StoreIfNone("SIGTERM")  // first reason is kept
+func (a *AtomicOption[T]) StoreIfNone(v T) bool {
+	return a.p.CompareAndSwap(nil, &v)
+}
+
+// CompareAndSwap sets a to new if its current option equals old (as with Equals), and
+// reports whether it did. It is a function rather than a method because it requires a
+// comparable T.
+//
+// Example:
+//
+//	CompareAndSwap(&leader, Some("node-2"), Some("node-3"))
+func CompareAndSwap[T comparable](a *AtomicOption[T], old, new Option[T]) bool {
+	np := boxOption(new)
+	for {
+		cur := a.p.Load()
+		if !Equals(FromPtr(cur), old) {
+			return false
+		}
+		if a.p.CompareAndSwap(cur, np) {
+			return true
+		}
+	}
+}
+
+// boxOption returns a pointer to a copy of o's value, or nil if o is None.
+func boxOption[T any](o Option[T]) *T {
+	if !o.ok {
+		return nil
+	}
+	return &o.value
+}
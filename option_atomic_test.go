@@ -0,0 +1,95 @@
+package gopt
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestAtomicOption(t *testing.T) {
+	var a AtomicOption[int]
+	if a.Load().IsSome() {
+		t.Fatal("zero AtomicOption should be None")
+	}
+	a.Store(Some(1))
+	if v := a.Load(); v.UnwrapOr(0) != 1 {
+		t.Fatalf("Load() = %v; want Some(1)", v)
+	}
+	if prev := a.Swap(Some(2)); prev.UnwrapOr(0) != 1 {
+		t.Fatalf("Swap() = %v; want Some(1)", prev)
+	}
+	if prev := a.Take(); prev.UnwrapOr(0) != 2 || a.Load().IsSome() {
+		t.Fatalf("Take() = %v, then Load() = %v; want Some(2), None", prev, a.Load())
+	}
+	if !a.StoreIfNone(3) || a.StoreIfNone(4) {
+		t.Fatal("StoreIfNone should succeed only while None")
+	}
+	if v := a.Load(); v.UnwrapOr(0) != 3 {
+		t.Fatalf("Load() = %v; want Some(3)", v)
+	}
+	a.Store(None[int]())
+	if a.Load().IsSome() {
+		t.Fatal("Store(None) should clear")
+	}
+	if v := NewAtomicOption(Some("x")).Load(); v.UnwrapOr("") != "x" {
+		t.Fatalf("NewAtomicOption(Some(x)).Load() = %v", v)
+	}
+}
+
+func TestAtomicOptionCompareAndSwap(t *testing.T) {
+	a := NewAtomicOption(Some("node-2"))
+	if CompareAndSwap(a, Some("node-1"), Some("node-3")) {
+		t.Fatal("CompareAndSwap with wrong old should fail")
+	}
+	if !CompareAndSwap(a, Some("node-2"), None[string]()) || a.Load().IsSome() {
+		t.Fatal("CompareAndSwap(Some(node-2), None) should succeed")
+	}
+	if !CompareAndSwap(a, None[string](), Some("node-4")) || a.Load().UnwrapOr("") != "node-4" {
+		t.Fatal("CompareAndSwap(None, Some(node-4)) should succeed")
+	}
+}
+
+func TestAtomicOptionConcurrent(t *testing.T) {
+	var a AtomicOption[int]
+	var wins, taken atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < 32; i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if a.StoreIfNone(i) {
+				wins.Add(1)
+			}
+			a.Load()
+			if a.Take().IsSome() {
+				taken.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	if wins.Load() < 1 || wins.Load() != taken.Load() {
+		t.Fatalf("StoreIfNone wins = %d, Take successes = %d; want equal and > 0", wins.Load(), taken.Load())
+	}
+
+	var counter AtomicOption[int]
+	counter.Store(Some(0))
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				for {
+					cur := counter.Load()
+					if CompareAndSwap(&counter, cur, Map(cur, func(n int) int { return n + 1 })) {
+						break
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if v := counter.Load(); v.UnwrapOr(0) != 800 {
+		t.Fatalf("counter = %v; want Some(800)", v)
+	}
+}
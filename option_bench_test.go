@@ -1,6 +1,7 @@
 package gopt
 
 import (
+	"sync"
 	"testing"
 )
 
@@ -78,3 +79,169 @@ func BenchmarkMatch(b *testing.B) {
 		_ = Match(o, onSome, onNone)
 	}
 }
+
+const benchCacheSize = 1 << 16
+
+func BenchmarkSliceOptionPtr(b *testing.B) {
+	x := 1
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s := make([]Option[*int], benchCacheSize)
+		for j := range s {
+			if j%2 == 0 {
+				s[j] = Some(&x)
+			}
+		}
+	}
+}
+
+func BenchmarkSlicePtr(b *testing.B) {
+	x := 1
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s := make([]Ptr[int], benchCacheSize)
+		for j := range s {
+			if j%2 == 0 {
+				s[j] = PtrOf(&x)
+			}
+		}
+	}
+}
+
+func BenchmarkSliceOptionFloat64(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s := make([]Option[float64], benchCacheSize)
+		for j := range s {
+			if j%2 == 0 {
+				s[j] = Some(float64(j))
+			}
+		}
+	}
+}
+
+func BenchmarkSliceSentinelFloat64(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s := make([]Sentinel[float64], benchCacheSize)
+		for j := range s {
+			if j%2 == 0 {
+				s[j], _ = NewSentinel(float64(j))
+			} else {
+				s[j] = NoneSentinel[float64]()
+			}
+		}
+	}
+}
+
+func BenchmarkSumOptionSlice(b *testing.B) {
+	opts := make([]Option[float64], benchCacheSize)
+	for j := range opts {
+		if j%10 != 0 {
+			opts[j] = Some(float64(j))
+		}
+	}
+	b.Run("[]Option", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			var sum float64
+			for _, o := range opts {
+				if v, ok := o.Get(); ok {
+					sum += v
+				}
+			}
+			_ = sum
+		}
+	})
+	b.Run("OptionSlice", func(b *testing.B) {
+		s := OptionSliceOf(opts)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_ = SliceSum(s)
+		}
+	})
+}
+
+func BenchmarkDo(b *testing.B) {
+	x, y := Some(1), Some(2)
+	b.Run("AndThen", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = AndThen(x, func(a int) Option[int] {
+				return Map(y, func(c int) int { return a + c })
+			})
+		}
+	})
+	b.Run("Some", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = Do(func(bd *Binder) int { return Bind(bd, x) + Bind(bd, y) })
+		}
+	})
+	b.Run("None", func(b *testing.B) {
+		n := None[int]()
+		for i := 0; i < b.N; i++ {
+			_ = Do(func(bd *Binder) int { return Bind(bd, x) + Bind(bd, n) })
+		}
+	})
+}
+
+// mutexOption is the mutex-guarded Option that AtomicOption replaces.
+type mutexOption[T any] struct {
+	mu sync.RWMutex
+	o  Option[T]
+}
+
+func (m *mutexOption[T]) Load() Option[T] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.o
+}
+
+func (m *mutexOption[T]) Store(o Option[T]) {
+	m.mu.Lock()
+	m.o = o
+	m.mu.Unlock()
+}
+
+func BenchmarkAtomicOption(b *testing.B) {
+	b.Run("Load/Atomic", func(b *testing.B) {
+		a := NewAtomicOption(Some(42))
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_ = a.Load()
+			}
+		})
+	})
+	b.Run("Load/Mutex", func(b *testing.B) {
+		m := &mutexOption[int]{o: Some(42)}
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_ = m.Load()
+			}
+		})
+	})
+	b.Run("Mixed/Atomic", func(b *testing.B) {
+		a := NewAtomicOption(Some(42))
+		b.RunParallel(func(pb *testing.PB) {
+			i := 0
+			for pb.Next() {
+				if i++; i%16 == 0 {
+					a.Store(Some(i))
+				} else {
+					_ = a.Load()
+				}
+			}
+		})
+	})
+	b.Run("Mixed/Mutex", func(b *testing.B) {
+		m := &mutexOption[int]{o: Some(42)}
+		b.RunParallel(func(pb *testing.PB) {
+			i := 0
+			for pb.Next() {
+				if i++; i%16 == 0 {
+					m.Store(Some(i))
+				} else {
+					_ = m.Load()
+				}
+			}
+		})
+	})
+}
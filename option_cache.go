@@ -0,0 +1,215 @@
+package gopt
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// CacheOptions configures a Cache. The zero value gives an unbounded cache whose entries,
+// Some and None alike, never expire.
+type CacheOptions struct {
+	Capacity int           // maximum number of entries, evicting the least recently used; 0 means no limit
+	TTL      time.Duration // lifetime of Some entries; 0 means no expiry
+	NoneTTL  time.Duration // lifetime of None entries; 0 means TTL, negative means None is not cached
+	Clock    Clock         // time source for expiry; nil means SystemClock
+}
+
+// Cache is a concurrency-safe cache of optional values. It remembers absence as well as
+// presence: a cached None (e.g. "user not found") is a hit, which Get tells apart from a miss.
+// Create with NewCache.
+//
+// Example:
+//
+//	users := NewCache[int, User](CacheOptions{Capacity: 10_000, TTL: time.Hour, NoneTTL: time.Minute})
+//	u := users.GetOrLoad(id, func() Option[User] { return db.FindUser(id) })
+type Cache[K comparable, V any] struct {
+	opts    CacheOptions
+	mu      sync.Mutex
+	items   map[K]*list.Element // values are *cacheEntry[K, V]
+	lru     *list.List          // front is most recently used
+	loading map[K]*cacheCall[V]
+}
+
+type cacheEntry[K comparable, V any] struct {
+	key     K
+	val     Option[V]
+	expires time.Time // zero means never
+}
+
+// cacheCall is an in-flight GetOrLoad shared by concurrent callers for the same key.
+type cacheCall[V any] struct {
+	done  chan struct{}
+	val   Option[V]
+	stale bool // key was Set or deleted during the load; guarded by Cache.mu
+}
+
+// NewCache returns an empty Cache configured by opts.
+//
+// Example:
+//
+//	c := NewCache[string, Config](CacheOptions{TTL: 5 * time.Minute})
+func NewCache[K comparable, V any](opts CacheOptions) *Cache[K, V] {
+	if opts.Clock == nil {
+		opts.Clock = SystemClock
+	}
+	return &Cache[K, V]{
+		opts:    opts,
+		items:   make(map[K]*list.Element),
+		lru:     list.New(),
+		loading: make(map[K]*cacheCall[V]),
+	}
+}
+
+// Get returns None on a miss, Some(None) if absence of the value is cached, and
+// Some(Some(v)) if v is cached. Use Flatten when the distinction does not matter.
+//
+// Example:
+//
+//	switch hit := c.Get(id); {
+//	case hit.IsNone():          // not cached
+//	case hit.Unwrap().IsNone(): // cached "not found"
+//	}
+func (c *Cache[K, V]) Get(key K) Option[Option[V]] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.get(key)
+}
+
+// get looks key up and marks it recently used; c.mu must be held.
+func (c *Cache[K, V]) get(key K) Option[Option[V]] {
+	el, ok := c.items[key]
+	if !ok {
+		return None[Option[V]]()
+	}
+	e := el.Value.(*cacheEntry[K, V])
+	if !e.expires.IsZero() && !c.opts.Clock.Now().Before(e.expires) {
+		c.remove(el)
+		return None[Option[V]]()
+	}
+	c.lru.MoveToFront(el)
+	return Some(e.val)
+}
+
+// Set caches o for key, replacing any previous entry. A None is not stored if NoneTTL is
+// negative; any previous entry is still removed.
+//
+// Example:
+//
+//	c.Set(id, None[User]())  // remember "not found"
+func (c *Cache[K, V]) Set(key K, o Option[V]) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.invalidateLoad(key)
+	c.set(key, o)
+}
+
+// set stores o for key and evicts beyond capacity; c.mu must be held.
+func (c *Cache[K, V]) set(key K, o Option[V]) {
+	if el, ok := c.items[key]; ok {
+		c.remove(el)
+	}
+	ttl := c.opts.TTL
+	if !o.ok && c.opts.NoneTTL != 0 {
+		ttl = c.opts.NoneTTL
+	}
+	if ttl < 0 {
+		return
+	}
+	e := &cacheEntry[K, V]{key: key, val: o}
+	if ttl > 0 {
+		e.expires = c.opts.Clock.Now().Add(ttl)
+	}
+	c.items[key] = c.lru.PushFront(e)
+	if c.opts.Capacity > 0 && c.lru.Len() > c.opts.Capacity {
+		c.remove(c.lru.Back())
+	}
+}
+
+// invalidateLoad stops an in-flight GetOrLoad for key from caching its result, so it cannot
+// overwrite a newer Set or Delete; c.mu must be held.
+func (c *Cache[K, V]) invalidateLoad(key K) {
+	if call, ok := c.loading[key]; ok {
+		call.stale = true
+	}
+}
+
+func (c *Cache[K, V]) remove(el *list.Element) {
+	c.lru.Remove(el)
+	delete(c.items, el.Value.(*cacheEntry[K, V]).key)
+}
+
+// Delete removes key from the cache.
+//
+// Example:
+//
+//	c.Delete(id)  // after the user is created
+func (c *Cache[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.invalidateLoad(key)
+	if el, ok := c.items[key]; ok {
+		c.remove(el)
+	}
+}
+
+// deleteIf removes key if its entry, expired or not, satisfies pred. The check and the removal
+// happen under one lock, so a concurrent Set is never removed by mistake. Unlike Delete it
+// leaves an in-flight GetOrLoad for key free to cache its result.
+func (c *Cache[K, V]) deleteIf(key K, pred func(Option[V]) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[key]; ok && pred(el.Value.(*cacheEntry[K, V]).val) {
+		c.remove(el)
+	}
+}
+
+// Len returns the number of entries, including expired ones not yet removed.
+//
+// Example:
+//
+//	n := c.Len()
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+// GetOrLoad returns the cached option for key, calling loader and caching its result on a
+// miss. Concurrent calls for the same key share one loader call. If key is Set or deleted
+// while loader runs, the loaded value is returned but not cached, so the newer write wins.
+// If loader panics, nothing is cached, the panic propagates to the caller that ran it, and
+// the others get None.
+//
+// Example:
+//
+//	u := users.GetOrLoad(id, func() Option[User] { return db.FindUser(id) })
+func (c *Cache[K, V]) GetOrLoad(key K, loader func() Option[V]) Option[V] {
+	c.mu.Lock()
+	if hit := c.get(key); hit.ok {
+		c.mu.Unlock()
+		return hit.value
+	}
+	if call, ok := c.loading[key]; ok {
+		c.mu.Unlock()
+		<-call.done
+		return call.val
+	}
+	call := &cacheCall[V]{done: make(chan struct{})}
+	c.loading[key] = call
+	c.mu.Unlock()
+
+	loaded := false
+	defer func() {
+		c.mu.Lock()
+		delete(c.loading, key)
+		if loaded && !call.stale {
+			c.set(key, call.val)
+		}
+		c.mu.Unlock()
+		close(call.done)
+	}()
+	call.val = loader()
+	loaded = true
+	return call.val
+}
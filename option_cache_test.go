@@ -0,0 +1,144 @@
+package gopt
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCacheGet(t *testing.T) {
+	c := NewCache[string, int](CacheOptions{})
+	if c.Get("a").IsSome() {
+		t.Fatal("Get on an empty cache should be a miss")
+	}
+	c.Set("a", Some(1))
+	c.Set("b", None[int]())
+	if v := c.Get("a"); !v.IsSome() || v.Unwrap().UnwrapOr(0) != 1 {
+		t.Fatalf("Get(a) = %v; want Some(Some(1))", v)
+	}
+	if v := c.Get("b"); !v.IsSome() || v.Unwrap().IsSome() {
+		t.Fatalf("Get(b) = %v; want Some(None)", v)
+	}
+	c.Delete("a")
+	if c.Get("a").IsSome() || c.Len() != 1 {
+		t.Fatalf("after Delete: Get(a) = %v, Len = %d; want miss, 1", c.Get("a"), c.Len())
+	}
+}
+
+func TestCacheTTL(t *testing.T) {
+	clock := &fakeClock{}
+	c := NewCache[string, int](CacheOptions{TTL: time.Minute, NoneTTL: 10 * time.Second, Clock: clock})
+	c.Set("some", Some(1))
+	c.Set("none", None[int]())
+	clock.After(10 * time.Second)
+	if c.Get("none").IsSome() {
+		t.Fatal("None entry should expire after NoneTTL")
+	}
+	if c.Get("some").IsNone() {
+		t.Fatal("Some entry should still be cached")
+	}
+	clock.After(time.Minute)
+	if c.Get("some").IsSome() || c.Len() != 0 {
+		t.Fatal("Some entry should expire after TTL")
+	}
+
+	nc := NewCache[string, int](CacheOptions{NoneTTL: -1})
+	nc.Set("k", Some(1))
+	nc.Set("k", None[int]())
+	if nc.Get("k").IsSome() {
+		t.Fatal("with negative NoneTTL, None should not be cached")
+	}
+}
+
+func TestCacheLRU(t *testing.T) {
+	c := NewCache[int, int](CacheOptions{Capacity: 2})
+	c.Set(1, Some(1))
+	c.Set(2, Some(2))
+	c.Get(1)
+	c.Set(3, Some(3))
+	if c.Get(2).IsSome() {
+		t.Fatal("least recently used entry 2 should be evicted")
+	}
+	if c.Get(1).IsNone() || c.Get(3).IsNone() || c.Len() != 2 {
+		t.Fatal("entries 1 and 3 should remain")
+	}
+}
+
+func TestCacheGetOrLoad(t *testing.T) {
+	c := NewCache[string, int](CacheOptions{})
+	var calls atomic.Int32
+	release := make(chan struct{})
+	loader := func() Option[int] {
+		calls.Add(1)
+		<-release
+		return None[int]()
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if c.GetOrLoad("k", loader).IsSome() {
+				t.Error("GetOrLoad should return the loader's None")
+			}
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	if n := calls.Load(); n != 1 {
+		t.Fatalf("loader called %d times; want 1", n)
+	}
+	if c.GetOrLoad("k", loader); calls.Load() != 1 {
+		t.Fatal("cached None should be a hit")
+	}
+	if v := c.GetOrLoad("x", func() Option[int] { return Some(7) }); v.UnwrapOr(0) != 7 {
+		t.Fatalf("GetOrLoad(x) = %v; want Some(7)", v)
+	}
+}
+
+func TestCacheGetOrLoadPanic(t *testing.T) {
+	c := NewCache[string, int](CacheOptions{})
+	func() {
+		defer func() { recover() }()
+		c.GetOrLoad("k", func() Option[int] { panic("boom") })
+	}()
+	if c.Get("k").IsSome() {
+		t.Fatal("a panicking loader should cache nothing")
+	}
+	if v := c.GetOrLoad("k", func() Option[int] { return Some(1) }); v.UnwrapOr(0) != 1 {
+		t.Fatalf("GetOrLoad after panic = %v; want Some(1)", v)
+	}
+}
+
+func TestCacheGetOrLoadConcurrentWrite(t *testing.T) {
+	c := NewCache[string, int](CacheOptions{})
+	slowLoad := func(key string, write func()) Option[int] {
+		started, release := make(chan struct{}), make(chan struct{})
+		result := make(chan Option[int])
+		go func() {
+			result <- c.GetOrLoad(key, func() Option[int] {
+				close(started)
+				<-release
+				return Some(1)
+			})
+		}()
+		<-started
+		write()
+		close(release)
+		return <-result
+	}
+
+	if v := slowLoad("set", func() { c.Set("set", Some(2)) }); v.UnwrapOr(0) != 1 {
+		t.Fatalf("GetOrLoad = %v; want the loader's Some(1)", v)
+	}
+	if got := c.Get("set"); got.IsNone() || got.Unwrap().UnwrapOr(0) != 2 {
+		t.Fatalf("Get after Set during load = %v; want Some(Some(2))", got)
+	}
+
+	slowLoad("del", func() { c.Delete("del") })
+	if got := c.Get("del"); got.IsSome() {
+		t.Fatalf("Get after Delete during load = %v; want a miss", got)
+	}
+}
@@ -0,0 +1,139 @@
+package gopt
+
+import (
+	"context"
+	"reflect"
+	"time"
+)
+
+// Recv receives from ch and returns Some(value), or None if ch is closed or ctx is done first.
+//
+// Example:
+//
+//	for job := Recv(ctx, jobs); job.IsSome(); job = Recv(ctx, jobs) {
+//		process(job.Unwrap())
+//	}
+func Recv[T any](ctx context.Context, ch <-chan T) Option[T] {
+	select {
+	case v, ok := <-ch:
+		return FromTuple(v, ok)
+	case <-ctx.Done():
+		return None[T]()
+	}
+}
+
+// TryRecv receives from ch without blocking: Some(value) if one is ready, None if ch is
+// empty or closed.
+//
+// Example:
+//
+//	if msg, ok := TryRecv(inbox).Get(); ok { handle(msg) }
+func TryRecv[T any](ch <-chan T) Option[T] {
+	select {
+	case v, ok := <-ch:
+		return FromTuple(v, ok)
+	default:
+		return None[T]()
+	}
+}
+
+// TrySend sends v on ch without blocking and reports whether it was sent.
+// Like a plain send, it panics if ch is closed.
+//
+// Example:
+//
+//	if !TrySend(events, ev) { dropped.Inc() }
+func TrySend[T any](ch chan<- T, v T) bool {
+	select {
+	case ch <- v:
+		return true
+	default:
+		return false
+	}
+}
+
+// RecvTimeout receives from ch and returns Some(value), or None if ch is closed or d elapses first.
+//
+// Example:
+//
+//	ack := RecvTimeout(acks, time.Second)
+func RecvTimeout[T any](ch <-chan T, d time.Duration) Option[T] {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case v, ok := <-ch:
+		return FromTuple(v, ok)
+	case <-t.C:
+		return None[T]()
+	}
+}
+
+// SelectSome receives from whichever of chs is ready first and returns its index and
+// Some(value). Closed channels are skipped and the others are still waited on; when all
+// are closed, or ctx is done, it returns -1 and None. Nil channels are never ready, as in
+// a select statement: with only nil channels left, SelectSome waits until ctx is done.
+// It uses reflect.Select, so it is slower than a select statement; prefer one when the
+// set of channels is fixed.
+//
+// Example:
+//
+//	i, v := SelectSome(ctx, primary, fallback)
+func SelectSome[T any](ctx context.Context, chs ...<-chan T) (int, Option[T]) {
+	cases := make([]reflect.SelectCase, len(chs)+1)
+	cases[0] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())}
+	open, hasNil := 0, false
+	for i, ch := range chs {
+		cases[i+1] = reflect.SelectCase{Dir: reflect.SelectRecv}
+		if ch != nil {
+			cases[i+1].Chan = reflect.ValueOf(ch)
+			open++
+		} else {
+			hasNil = true
+		}
+	}
+	for open > 0 || hasNil {
+		chosen, v, ok := reflect.Select(cases)
+		if chosen == 0 {
+			break
+		}
+		if !ok {
+			cases[chosen].Chan = reflect.Value{}
+			open--
+			continue
+		}
+		val, _ := v.Interface().(T) // a nil interface value fails the assertion; val stays nil
+		return chosen - 1, Some(val)
+	}
+	return -1, None[T]()
+}
+
+// Drain returns an iterator, compatible with iter.Seq[T], over the values already waiting
+// in ch. It stops without blocking as soon as ch is empty or closed.
+//
+// Example:
+//
+//	Drain(results)(func(r Result) bool { merge(r); return true })
+func Drain[T any](ch <-chan T) func(yield func(T) bool) {
+	return func(yield func(T) bool) {
+		for {
+			v, ok := TryRecv(ch).Get()
+			if !ok || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// DrainSlice returns the values already waiting in ch, without blocking; see Drain.
+//
+// Example:
+//
+//	batch := DrainSlice(queue)
+func DrainSlice[T any](ch <-chan T) []T {
+	var out []T
+	Drain(ch)(func(v T) bool {
+		out = append(out, v)
+		return true
+	})
+	return out
+}
@@ -0,0 +1,108 @@
+package gopt
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestRecv(t *testing.T) {
+	ctx := context.Background()
+	ch := make(chan int, 1)
+	ch <- 1
+	if v := Recv(ctx, ch); v.UnwrapOr(0) != 1 {
+		t.Fatalf("Recv() = %v; want Some(1)", v)
+	}
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	if Recv(cctx, ch).IsSome() {
+		t.Fatal("Recv() on a cancelled context should be None")
+	}
+	close(ch)
+	if Recv(ctx, ch).IsSome() {
+		t.Fatal("Recv() on a closed channel should be None")
+	}
+}
+
+func TestTryRecvTrySend(t *testing.T) {
+	ch := make(chan int, 1)
+	if TryRecv(ch).IsSome() {
+		t.Fatal("TryRecv() on an empty channel should be None")
+	}
+	if !TrySend(ch, 1) || TrySend(ch, 2) {
+		t.Fatal("TrySend should succeed once on a channel with capacity 1")
+	}
+	if v := TryRecv(ch); v.UnwrapOr(0) != 1 {
+		t.Fatalf("TryRecv() = %v; want Some(1)", v)
+	}
+	close(ch)
+	if TryRecv(ch).IsSome() {
+		t.Fatal("TryRecv() on a closed channel should be None")
+	}
+}
+
+func TestRecvTimeout(t *testing.T) {
+	ch := make(chan string)
+	if RecvTimeout(ch, 5*time.Millisecond).IsSome() {
+		t.Fatal("RecvTimeout() should be None after the timeout")
+	}
+	go func() { ch <- "a" }()
+	if v := RecvTimeout(ch, time.Second); v.UnwrapOr("") != "a" {
+		t.Fatalf("RecvTimeout() = %v; want Some(a)", v)
+	}
+}
+
+func TestSelectSome(t *testing.T) {
+	ctx := context.Background()
+	a, b := make(chan int), make(chan int, 1)
+	b <- 2
+	if i, v := SelectSome(ctx, a, b); i != 1 || v.UnwrapOr(0) != 2 {
+		t.Fatalf("SelectSome() = %d, %v; want 1, Some(2)", i, v)
+	}
+	close(a)
+	go func() { b <- 3 }()
+	if i, v := SelectSome(ctx, a, nil, b); i != 2 || v.UnwrapOr(0) != 3 {
+		t.Fatalf("SelectSome() with a closed channel = %d, %v; want 2, Some(3)", i, v)
+	}
+	close(b)
+	if i, v := SelectSome(ctx, a, b); i != -1 || v.IsSome() {
+		t.Fatalf("SelectSome() on closed channels = %d, %v; want -1, None", i, v)
+	}
+	cctx, cancel := context.WithTimeout(ctx, 5*time.Millisecond)
+	defer cancel()
+	if i, v := SelectSome(cctx, make(chan int)); i != -1 || v.IsSome() {
+		t.Fatalf("SelectSome() after timeout = %d, %v; want -1, None", i, v)
+	}
+
+	nctx, ncancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer ncancel()
+	start := time.Now()
+	if i, v := SelectSome[int](nctx, nil, a, nil); i != -1 || v.IsSome() {
+		t.Fatalf("SelectSome() on nil channels = %d, %v; want -1, None", i, v)
+	}
+	if d := time.Since(start); d < 20*time.Millisecond {
+		t.Fatalf("SelectSome() on nil and closed channels returned after %v; want it to wait for ctx", d)
+	}
+}
+
+func TestDrain(t *testing.T) {
+	ch := make(chan int, 4)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	var first []int
+	Drain(ch)(func(v int) bool {
+		first = append(first, v)
+		return v < 2
+	})
+	if !slices.Equal(first, []int{1, 2}) {
+		t.Fatalf("Drain with early stop = %v; want [1 2]", first)
+	}
+	if got := DrainSlice(ch); !slices.Equal(got, []int{3}) {
+		t.Fatalf("DrainSlice() = %v; want [3]", got)
+	}
+	if got := DrainSlice(ch); got != nil {
+		t.Fatalf("DrainSlice() on an empty channel = %v; want nil", got)
+	}
+}
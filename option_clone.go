@@ -0,0 +1,118 @@
+package gopt
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Clone returns Some(cloneT(value)) if o is Some, otherwise None.
+// Use it when T holds a slice, map or pointer, since copying an Option shares those.
+//
+// Example:
+//
+//	c := Clone(Some([]int{1, 2}), slices.Clone[[]int])  // independent backing array
+func Clone[T any](o Option[T], cloneT func(T) T) Option[T] {
+	if !o.ok {
+		return o
+	}
+	return Some(cloneT(o.value))
+}
+
+// DeepClone returns a deep copy of o using reflection: slices, maps, pointers, arrays,
+// interfaces, exported struct fields and nested Options are copied recursively. Other
+// unexported struct fields, channels and functions are copied shallowly. Cyclic values
+// are not supported.
+//
+// Example:
+//
+//	o := Some(map[string][]int{"a": {1}})
+//	c := DeepClone(o)
+//	c.Unwrap()["a"][0] = 9  // o is unchanged
+func DeepClone[T any](o Option[T]) Option[T] {
+	if !o.ok {
+		return o
+	}
+	src := reflect.ValueOf(&o.value).Elem()
+	dst := reflect.New(src.Type()).Elem()
+	deepCopy(dst, src)
+	return Some(dst.Interface().(T))
+}
+
+// deepCloner is implemented by Option so that DeepClone can reach values stored in
+// its unexported fields.
+type deepCloner interface {
+	deepCloneAny() any
+}
+
+func (o Option[T]) deepCloneAny() any {
+	return DeepClone(o)
+}
+
+var optionPkgPath = reflect.TypeOf(Option[int]{}).PkgPath()
+
+// isOptionType reports whether t is an instantiation of Option itself. Pointers to Options
+// and structs embedding one also have deepCloneAny in their method sets, but must be copied
+// by kind.
+func isOptionType(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t.PkgPath() == optionPkgPath && strings.HasPrefix(t.Name(), "Option[")
+}
+
+// deepCopy copies src into the settable dst, recursing into reference types.
+func deepCopy(dst, src reflect.Value) {
+	if isOptionType(src.Type()) && src.CanInterface() {
+		if c, ok := src.Interface().(deepCloner); ok {
+			dst.Set(reflect.ValueOf(c.deepCloneAny()))
+			return
+		}
+	}
+	switch src.Kind() {
+	case reflect.Slice:
+		if src.IsNil() {
+			return
+		}
+		s := reflect.MakeSlice(src.Type(), src.Len(), src.Len())
+		for i := 0; i < src.Len(); i++ {
+			deepCopy(s.Index(i), src.Index(i))
+		}
+		dst.Set(s)
+	case reflect.Map:
+		if src.IsNil() {
+			return
+		}
+		m := reflect.MakeMapWithSize(src.Type(), src.Len())
+		iter := src.MapRange()
+		for iter.Next() {
+			v := reflect.New(src.Type().Elem()).Elem()
+			deepCopy(v, iter.Value())
+			m.SetMapIndex(iter.Key(), v)
+		}
+		dst.Set(m)
+	case reflect.Pointer:
+		if src.IsNil() {
+			return
+		}
+		p := reflect.New(src.Type().Elem())
+		deepCopy(p.Elem(), src.Elem())
+		dst.Set(p)
+	case reflect.Interface:
+		if src.IsNil() {
+			return
+		}
+		v := reflect.New(src.Elem().Type()).Elem()
+		deepCopy(v, src.Elem())
+		dst.Set(v)
+	case reflect.Array:
+		for i := 0; i < src.Len(); i++ {
+			deepCopy(dst.Index(i), src.Index(i))
+		}
+	case reflect.Struct:
+		dst.Set(src)
+		for i := 0; i < src.NumField(); i++ {
+			if f := dst.Field(i); f.CanSet() {
+				deepCopy(f, src.Field(i))
+			}
+		}
+	default:
+		dst.Set(src)
+	}
+}
@@ -0,0 +1,151 @@
+package gopt
+
+import (
+	"hash/maphash"
+	"slices"
+	"testing"
+)
+
+func TestEqualFunc(t *testing.T) {
+	eq := slices.Equal[[]int]
+	if !EqualFunc(Some([]int{1}), Some([]int{1}), eq) || !EqualFunc(None[[]int](), None[[]int](), eq) {
+		t.Fatal("EqualFunc should be true for equal Somes and for two Nones")
+	}
+	if EqualFunc(Some([]int{1}), Some([]int{2}), eq) || EqualFunc(Some([]int{1}), None[[]int](), eq) {
+		t.Fatal("EqualFunc should be false for different values or Some vs None")
+	}
+}
+
+type version struct{ major, minor int }
+
+func (v version) Equal(o version) bool { return v.major == o.major }
+
+func TestEqualMethod(t *testing.T) {
+	if !Some([]string{"a"}).Equal(Some([]string{"a"})) || Some([]string{"a"}).Equal(Some([]string{"b"})) {
+		t.Fatal("Equal should compare slices deeply")
+	}
+	if !Some(map[string]int{"a": 1}).Equal(Some(map[string]int{"a": 1})) {
+		t.Fatal("Equal should compare maps deeply")
+	}
+	if !None[[]int]().Equal(None[[]int]()) || None[[]int]().Equal(Some([]int{})) {
+		t.Fatal("Equal mismatch on None")
+	}
+	if !Some(version{1, 2}).Equal(Some(version{1, 3})) {
+		t.Fatal("Equal should use T's Equal method")
+	}
+	if !Some(Some([]int{1})).Equal(Some(Some([]int{1}))) {
+		t.Fatal("Equal should work on nested Options")
+	}
+}
+
+func TestHash(t *testing.T) {
+	seed := maphash.MakeSeed()
+	sum := func(o Option[[]byte]) uint64 {
+		var h maphash.Hash
+		h.SetSeed(seed)
+		Hash(&h, o, func(h *maphash.Hash, b []byte) { h.Write(b) })
+		return h.Sum64()
+	}
+	if sum(Some([]byte("k"))) != sum(Some([]byte("k"))) {
+		t.Fatal("equal Options should hash equally")
+	}
+	if sum(None[[]byte]()) == sum(Some([]byte{})) {
+		t.Fatal("None and Some(empty) should hash differently")
+	}
+}
+
+func TestClone(t *testing.T) {
+	o := Some([]int{1, 2})
+	c := Clone(o, slices.Clone[[]int])
+	c.Unwrap()[0] = 9
+	if o.Unwrap()[0] != 1 {
+		t.Fatal("Clone should not share the backing array")
+	}
+	if Clone(None[[]int](), slices.Clone[[]int]).IsSome() {
+		t.Fatal("Clone(None) should be None")
+	}
+}
+
+func TestDeepClone(t *testing.T) {
+	type inner struct {
+		Tags Option[[]string]
+		Ptr  *int
+	}
+	type record struct {
+		Name  string
+		Items map[string][]int
+		Inner inner
+		Arr   [1][]int
+		Any   any
+	}
+	n := 5
+	o := Some(record{
+		Name:  "r",
+		Items: map[string][]int{"a": {1}},
+		Inner: inner{Tags: Some([]string{"x"}), Ptr: &n},
+		Arr:   [1][]int{{1}},
+		Any:   []int{1},
+	})
+	c := DeepClone(o)
+	if !c.Equal(o) {
+		t.Fatal("DeepClone should produce an equal value")
+	}
+	r := c.Unwrap()
+	r.Items["a"][0] = 9
+	r.Inner.Tags.Unwrap()[0] = "y"
+	*r.Inner.Ptr = 6
+	r.Arr[0][0] = 9
+	r.Any.([]int)[0] = 9
+	orig := o.Unwrap()
+	if orig.Items["a"][0] != 1 || orig.Inner.Tags.Unwrap()[0] != "x" || n != 5 ||
+		orig.Arr[0][0] != 1 || orig.Any.([]int)[0] != 1 {
+		t.Fatalf("DeepClone shares storage with the original: %+v", orig)
+	}
+	if DeepClone(None[[]int]()).IsSome() {
+		t.Fatal("DeepClone(None) should be None")
+	}
+}
+
+type embedsOption struct {
+	Option[[]int]
+	Name string
+}
+
+func TestDeepCloneOptionContainers(t *testing.T) {
+	inner := Some([]int{1})
+	pc := DeepClone(Some(&inner)).Unwrap()
+	if pc == &inner {
+		t.Fatal("DeepClone(Some(&opt)) should copy the pointed-to Option")
+	}
+	pc.Unwrap()[0] = 9
+	if inner.Unwrap()[0] != 1 {
+		t.Fatal("DeepClone(Some(&opt)) shares the Option's slice")
+	}
+
+	e := DeepClone(Some(embedsOption{Option: Some([]int{1}), Name: "e"})).Unwrap()
+	if e.Name != "e" || e.Unwrap()[0] != 1 {
+		t.Fatalf("DeepClone(embedding struct) = %+v; want {Some([1]) e}", e)
+	}
+	orig := embedsOption{Option: Some([]int{1})}
+	DeepClone(Some(orig)).Unwrap().Unwrap()[0] = 9
+	if orig.Unwrap()[0] != 1 {
+		t.Fatal("DeepClone(embedding struct) shares the Option's slice")
+	}
+	var nilEmbed struct{ *Option[int] }
+	if DeepClone(Some(nilEmbed)).Unwrap().Option != nil {
+		t.Fatal("DeepClone should keep a nil embedded *Option nil")
+	}
+
+	sl := []Option[[]int]{Some([]int{1}), None[[]int]()}
+	cs := DeepClone(Some(sl)).Unwrap()
+	cs[0].Unwrap()[0] = 9
+	if sl[0].Unwrap()[0] != 1 || cs[1].IsSome() {
+		t.Fatalf("DeepClone(slice of Options) = %v; want an independent copy of %v", cs, sl)
+	}
+	m := map[string]Option[[]int]{"a": Some([]int{1})}
+	cm := DeepClone(Some(m)).Unwrap()
+	cm["a"].Unwrap()[0] = 9
+	if m["a"].Unwrap()[0] != 1 {
+		t.Fatal("DeepClone(map of Options) shares the Option's slice")
+	}
+}
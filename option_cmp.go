@@ -0,0 +1,206 @@
+package gopt
+
+import (
+	"cmp"
+	"hash/maphash"
+	"reflect"
+)
+
+// NoneOrder selects where None sorts relative to Some in the comparator builders.
+type NoneOrder int
+
+const (
+	// NoneFirst sorts None before every Some value.
+	NoneFirst NoneOrder = iota
+	// NoneLast sorts None after every Some value.
+	NoneLast
+)
+
+// Compare returns -1, 0 or +1 depending on whether a is less than, equal to or greater than b.
+// None is less than any Some; two Somes compare their values with cmp.Compare.
+//
+// Example:
+//
+//	Compare(None[int](), Some(1))  // -1
+//	Compare(Some(2), Some(1))      // +1
+func Compare[T cmp.Ordered](a, b Option[T]) int {
+	return CompareFunc(a, b, cmp.Compare[T])
+}
+
+// CompareFunc is like Compare but compares Some values with fn.
+// None is less than any Some.
+//
+// Example:
+//
+//	CompareFunc(Some("b"), Some("A"), func(x, y string) int {
+//		return strings.Compare(strings.ToLower(x), strings.ToLower(y))
+//	})  // +1
+func CompareFunc[T any](a, b Option[T], fn func(T, T) int) int {
+	switch {
+	case a.ok && b.ok:
+		return fn(a.value, b.value)
+	case a.ok:
+		return 1
+	case b.ok:
+		return -1
+	}
+	return 0
+}
+
+// EqualFunc returns true if a and b are both None, or both Some with eq(a, b) true.
+// Unlike Equals, T need not be comparable.
+//
+// Example:
+//
+//	EqualFunc(Some([]int{1}), Some([]int{1}), slices.Equal[[]int])  // true
+func EqualFunc[T any](a, b Option[T], eq func(T, T) bool) bool {
+	if a.ok != b.ok {
+		return false
+	}
+	return !a.ok || eq(a.value, b.value)
+}
+
+// Equal reports whether o and other are both None, or both Some with equal values.
+// Values are compared with their own Equal(T) bool method when T has one, otherwise
+// with reflect.DeepEqual. The method shape lets go-cmp compare Options without options.
+//
+// Example:
+//
+//	Some([]string{"a"}).Equal(Some([]string{"a"}))  // true
+func (o Option[T]) Equal(other Option[T]) bool {
+	if o.ok != other.ok {
+		return false
+	}
+	if !o.ok {
+		return true
+	}
+	if e, ok := any(o.value).(interface{ Equal(T) bool }); ok {
+		return e.Equal(other.value)
+	}
+	return reflect.DeepEqual(o.value, other.value)
+}
+
+// Hash writes o to h so that Options can key a hash set: None and Some write different tags,
+// and hashT writes the value of a Some. Equal options must produce equal hashes.
+//
+// Example:
+//
+//	var h maphash.Hash
+//	Hash(&h, Some([]byte("k")), func(h *maphash.Hash, b []byte) { h.Write(b) })
+//	key := h.Sum64()
+func Hash[T any](h *maphash.Hash, o Option[T], hashT func(*maphash.Hash, T)) {
+	if !o.ok {
+		h.WriteByte(0)
+		return
+	}
+	h.WriteByte(1)
+	hashT(h, o.value)
+}
+
+// Less reports whether a sorts before b according to Compare.
+//
+// Example:
+//
+//	Less(None[int](), Some(0))  // true
+func Less[T cmp.Ordered](a, b Option[T]) bool {
+	return Compare(a, b) < 0
+}
+
+// Comparator returns a comparison function for slices.SortFunc that orders Some values
+// ascending and places None according to order.
+//
+// Example:
+//
+//	slices.SortFunc(opts, Comparator[int](NoneLast))  // [Some(1) Some(2) None]
+func Comparator[T cmp.Ordered](order NoneOrder) func(a, b Option[T]) int {
+	return ComparatorFunc(order, cmp.Compare[T])
+}
+
+// ComparatorFunc is like Comparator but compares Some values with fn.
+//
+// Example:
+//
+//	byLen := ComparatorFunc(NoneFirst, func(a, b string) int { return cmp.Compare(len(a), len(b)) })
+//	slices.SortFunc(names, byLen)
+func ComparatorFunc[T any](order NoneOrder, fn func(T, T) int) func(a, b Option[T]) int {
+	return func(a, b Option[T]) int {
+		c := CompareFunc(a, b, fn)
+		if order == NoneLast && a.ok != b.ok {
+			return -c
+		}
+		return c
+	}
+}
+
+// MinOption returns the smaller of the Some values among a and b. If only one is Some it is
+// returned; if both are None the result is None. Unlike Compare, None never wins.
+//
+// Example:
+//
+//	MinOption(Some(3), Some(1))     // Some(1)
+//	MinOption(None[int](), Some(3))  // Some(3)
+func MinOption[T cmp.Ordered](a, b Option[T]) Option[T] {
+	if !a.ok || (b.ok && cmp.Less(b.value, a.value)) {
+		return b
+	}
+	return a
+}
+
+// MaxOption returns the larger of the Some values among a and b. If only one is Some it is
+// returned; if both are None the result is None.
+//
+// Example:
+//
+//	MaxOption(Some(3), Some(1))     // Some(3)
+//	MaxOption(Some(3), None[int]())  // Some(3)
+func MaxOption[T cmp.Ordered](a, b Option[T]) Option[T] {
+	if !a.ok || (b.ok && cmp.Less(a.value, b.value)) {
+		return b
+	}
+	return a
+}
+
+// By returns a comparison function for slices.SortFunc that orders records of type S by an
+// optional key, ascending, with None placed according to order. Combine several with SortKeys.
+//
+// Example:
+//
+//	slices.SortFunc(users, By(func(u User) Option[string] { return u.Nickname }, NoneLast))
+func By[S any, T cmp.Ordered](key func(S) Option[T], order NoneOrder) func(a, b S) int {
+	c := Comparator[T](order)
+	return func(a, b S) int {
+		return c(key(a), key(b))
+	}
+}
+
+// ByDesc is like By but orders Some keys descending. None is still placed according to order.
+//
+// Example:
+//
+//	slices.SortFunc(users, ByDesc(func(u User) Option[int] { return u.Score }, NoneLast))
+func ByDesc[S any, T cmp.Ordered](key func(S) Option[T], order NoneOrder) func(a, b S) int {
+	c := ComparatorFunc(order, func(x, y T) int { return cmp.Compare(y, x) })
+	return func(a, b S) int {
+		return c(key(a), key(b))
+	}
+}
+
+// SortKeys combines comparison functions into one that compares by the first, then breaks
+// ties with the next, and so on. Use it with By and ByDesc to sort on several nullable columns.
+//
+// Example:
+//
+//	slices.SortFunc(rows, SortKeys(
+//		By(func(r Row) Option[string] { return r.Country }, NoneLast),
+//		ByDesc(func(r Row) Option[int] { return r.Score }, NoneLast),
+//	))
+func SortKeys[S any](cmps ...func(a, b S) int) func(a, b S) int {
+	return func(a, b S) int {
+		for _, c := range cmps {
+			if r := c(a, b); r != 0 {
+				return r
+			}
+		}
+		return 0
+	}
+}
@@ -0,0 +1,92 @@
+package gopt
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	cases := []struct {
+		a, b Option[int]
+		want int
+	}{
+		{None[int](), None[int](), 0},
+		{None[int](), Some(1), -1},
+		{Some(1), None[int](), 1},
+		{Some(1), Some(2), -1},
+		{Some(2), Some(2), 0},
+		{Some(3), Some(2), 1},
+	}
+	for _, c := range cases {
+		if got := Compare(c.a, c.b); got != c.want {
+			t.Fatalf("Compare(%v, %v) = %d; want %d", c.a, c.b, got, c.want)
+		}
+		if got := Less(c.a, c.b); got != (c.want < 0) {
+			t.Fatalf("Less(%v, %v) = %v; want %v", c.a, c.b, got, c.want < 0)
+		}
+	}
+	byLen := func(x, y string) int { return cmp.Compare(len(x), len(y)) }
+	if CompareFunc(Some("aaa"), Some("b"), byLen) != 1 {
+		t.Fatal("CompareFunc should use fn for Some values")
+	}
+}
+
+func TestComparator(t *testing.T) {
+	opts := []Option[int]{Some(2), None[int](), Some(1), None[int](), Some(3)}
+
+	first := slices.Clone(opts)
+	slices.SortFunc(first, Comparator[int](NoneFirst))
+	want := []Option[int]{None[int](), None[int](), Some(1), Some(2), Some(3)}
+	if !slices.EqualFunc(first, want, Equals[int]) {
+		t.Fatalf("NoneFirst sort = %v; want %v", first, want)
+	}
+
+	last := slices.Clone(opts)
+	slices.SortFunc(last, Comparator[int](NoneLast))
+	want = []Option[int]{Some(1), Some(2), Some(3), None[int](), None[int]()}
+	if !slices.EqualFunc(last, want, Equals[int]) {
+		t.Fatalf("NoneLast sort = %v; want %v", last, want)
+	}
+}
+
+func TestMinMaxOption(t *testing.T) {
+	if MinOption(Some(3), Some(1)).Unwrap() != 1 || MaxOption(Some(3), Some(1)).Unwrap() != 3 {
+		t.Fatal("MinOption/MaxOption of two Somes mismatch")
+	}
+	if MinOption(None[int](), Some(3)).Unwrap() != 3 || MinOption(Some(3), None[int]()).Unwrap() != 3 {
+		t.Fatal("MinOption should ignore None")
+	}
+	if MaxOption(None[int](), Some(3)).Unwrap() != 3 || MaxOption(Some(3), None[int]()).Unwrap() != 3 {
+		t.Fatal("MaxOption should ignore None")
+	}
+	if MinOption(None[int](), None[int]()).IsSome() || MaxOption(None[int](), None[int]()).IsSome() {
+		t.Fatal("MinOption/MaxOption of two Nones should be None")
+	}
+}
+
+func TestSortKeys(t *testing.T) {
+	type row struct {
+		name    string
+		country Option[string]
+		score   Option[int]
+	}
+	rows := []row{
+		{"a", Some("fr"), Some(1)},
+		{"b", None[string](), Some(9)},
+		{"c", Some("de"), None[int]()},
+		{"d", Some("fr"), Some(5)},
+		{"e", Some("de"), Some(2)},
+	}
+	slices.SortFunc(rows, SortKeys(
+		By(func(r row) Option[string] { return r.country }, NoneLast),
+		ByDesc(func(r row) Option[int] { return r.score }, NoneLast),
+	))
+	var got string
+	for _, r := range rows {
+		got += r.name
+	}
+	if got != "ecdab" {
+		t.Fatalf("multi-key sort order = %q; want \"ecdab\"", got)
+	}
+}
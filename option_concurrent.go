@@ -0,0 +1,199 @@
+package gopt
+
+import (
+	"context"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+)
+
+// AllSome runs fns concurrently and returns Some of their values, in the order of fns, if all
+// of them return Some. As soon as one returns None the context passed to the others is
+// cancelled and AllSome returns None. It waits for every started function to return, so fns
+// should honour ctx; functions not yet started when ctx is done are skipped and count as
+// None. If a function panics, the others are cancelled and AllSome panics with a
+// *PanicError carrying the original value and stack. With no fns it returns Some(empty slice).
+//
+// Example:
+//
+//	page := AllSome(ctx, fetchUser, fetchPrefs, fetchQuota)  // None if any is missing
+func AllSome[T any](ctx context.Context, fns ...func(context.Context) Option[T]) Option[[]T] {
+	return AllSomeLimit(ctx, 0, fns...)
+}
+
+// AllSomeLimit is like AllSome but runs at most limit functions at a time, on limit
+// goroutines that take fns in order; limit <= 0 means one goroutine per function.
+//
+// Example:
+//
+//	pages := AllSomeLimit(ctx, 4, fetchers...)
+func AllSomeLimit[T any](ctx context.Context, limit int, fns ...func(context.Context) Option[T]) Option[[]T] {
+	vals := make([]T, len(fns))
+	ok := true
+	runOptions(ctx, limit, fns, func(i int, o Option[T]) bool {
+		if !o.ok {
+			ok = false
+			return true
+		}
+		vals[i] = o.value
+		return false
+	})
+	if !ok {
+		return None[[]T]()
+	}
+	return Some(vals)
+}
+
+// FirstSome runs fns concurrently and returns the first Some to arrive, cancelling the
+// context passed to the others. It returns None if every function returns None.
+// Waiting, skipping and panics work as in AllSome.
+//
+// Example:
+//
+//	v := FirstSome(ctx, fromCache, fromReplica, fromPrimary)
+func FirstSome[T any](ctx context.Context, fns ...func(context.Context) Option[T]) Option[T] {
+	return FirstSomeLimit(ctx, 0, fns...)
+}
+
+// FirstSomeLimit is like FirstSome but runs at most limit functions at a time, on limit
+// goroutines that take fns in order; limit <= 0 means one goroutine per function.
+//
+// Example:
+//
+//	v := FirstSomeLimit(ctx, 2, mirrors...)
+func FirstSomeLimit[T any](ctx context.Context, limit int, fns ...func(context.Context) Option[T]) Option[T] {
+	var first Option[T]
+	runOptions(ctx, limit, fns, func(_ int, o Option[T]) bool {
+		first = o
+		return o.ok
+	})
+	if !first.ok {
+		return None[T]()
+	}
+	return first
+}
+
+// Quorum runs fns concurrently and returns Some of the first n values to arrive, in arrival
+// order, cancelling the others once n functions have returned Some. It returns None as soon
+// as too many have returned None for n to be reached. n <= 0 gives Some(empty slice) without
+// running anything. Waiting, skipping and panics work as in AllSome.
+//
+// Example:
+//
+//	votes := Quorum(ctx, 2, replicaA, replicaB, replicaC)  // any two replicas
+func Quorum[T any](ctx context.Context, n int, fns ...func(context.Context) Option[T]) Option[[]T] {
+	return QuorumLimit(ctx, n, 0, fns...)
+}
+
+// QuorumLimit is like Quorum but runs at most limit functions at a time, on limit
+// goroutines that take fns in order; limit <= 0 means one goroutine per function.
+//
+// Example:
+//
+//	votes := QuorumLimit(ctx, 3, 2, replicas...)
+func QuorumLimit[T any](ctx context.Context, n, limit int, fns ...func(context.Context) Option[T]) Option[[]T] {
+	if n <= 0 {
+		return Some([]T{})
+	}
+	if n > len(fns) {
+		return None[[]T]()
+	}
+	vals := make([]T, 0, n)
+	failed := 0
+	runOptions(ctx, limit, fns, func(_ int, o Option[T]) bool {
+		if o.ok {
+			vals = append(vals, o.value)
+		} else {
+			failed++
+		}
+		return len(vals) == n || len(fns)-failed < n
+	})
+	if len(vals) < n {
+		return None[[]T]()
+	}
+	return Some(vals)
+}
+
+// optionResult is the outcome of one function run by runOptions.
+type optionResult[T any] struct {
+	i     int
+	o     Option[T]
+	panic *PanicError
+}
+
+// runOptions runs fns on at most limit goroutines, or one per function if limit <= 0. Each
+// goroutine takes the next function in order, so fns start in order. handle is called on the
+// calling goroutine with each result as it arrives, until it returns true; the context of the
+// remaining functions is then cancelled and runOptions waits for the running ones to return.
+// Once the context is done, functions that have not started are skipped; if handle has not
+// stopped by then, it sees None for each of them after the running ones have returned.
+// The first panic cancels the rest and is re-raised as a *PanicError once all have returned.
+func runOptions[T any](ctx context.Context, limit int, fns []func(context.Context) Option[T], handle func(i int, o Option[T]) bool) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	workers := len(fns)
+	if limit > 0 {
+		workers = min(limit, workers)
+	}
+	results := make(chan optionResult[T], len(fns))
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				i := int(next.Add(1) - 1)
+				if i >= len(fns) || ctx.Err() != nil {
+					return
+				}
+				results <- runOption(ctx, cancel, i, fns[i])
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	var panicked *PanicError
+	stopped := false
+	seen := make([]bool, len(fns))
+	for r := range results {
+		seen[r.i] = true
+		switch {
+		case r.panic != nil:
+			if panicked == nil {
+				panicked = r.panic
+			}
+			cancel()
+		case !stopped && panicked == nil:
+			if handle(r.i, r.o) {
+				stopped = true
+				cancel()
+			}
+		}
+	}
+	if panicked != nil {
+		panic(panicked)
+	}
+	for i := 0; i < len(fns) && !stopped; i++ {
+		if !seen[i] {
+			stopped = handle(i, Option[T]{})
+		}
+	}
+}
+
+// runOption calls fn, turning a panic into a result carrying a *PanicError and cancelling ctx.
+func runOption[T any](ctx context.Context, cancel context.CancelFunc, i int, fn func(context.Context) Option[T]) (r optionResult[T]) {
+	r.i = i
+	defer func() {
+		if p := recover(); p != nil {
+			r.panic = &PanicError{Value: p, Stack: debug.Stack()}
+			cancel()
+		}
+	}()
+	r.o = fn(ctx)
+	return r
+}
@@ -0,0 +1,167 @@
+package gopt
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// valueAfter returns a function that yields o after d, or None if its context is cancelled first.
+func valueAfter[T any](d time.Duration, o Option[T]) func(context.Context) Option[T] {
+	return func(ctx context.Context) Option[T] {
+		select {
+		case <-time.After(d):
+			return o
+		case <-ctx.Done():
+			return None[T]()
+		}
+	}
+}
+
+func TestAllSome(t *testing.T) {
+	ctx := context.Background()
+	v := AllSome(ctx, valueAfter(20*time.Millisecond, Some(1)), valueAfter(0, Some(2)), valueAfter(10*time.Millisecond, Some(3)))
+	if got := v.UnwrapOr(nil); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Fatalf("AllSome = %v; want Some([1 2 3])", v)
+	}
+	if v := AllSome[int](ctx); v.IsNone() || len(v.Unwrap()) != 0 {
+		t.Fatalf("AllSome() = %v; want Some([])", v)
+	}
+
+	// AllSome waits for every started function, so finishing early shows slow was cancelled
+	// (or never started).
+	start := time.Now()
+	if AllSome(ctx, valueAfter(time.Second, Some(1)), valueAfter(0, None[int]())).IsSome() {
+		t.Fatal("AllSome with a None should be None")
+	}
+	if time.Since(start) > 500*time.Millisecond {
+		t.Fatal("AllSome should cancel the others after a None")
+	}
+}
+
+func TestAllSomeLimit(t *testing.T) {
+	var running, peak atomic.Int32
+	fn := func(ctx context.Context) Option[int] {
+		n := running.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		running.Add(-1)
+		return Some(int(n))
+	}
+	fns := []func(context.Context) Option[int]{fn, fn, fn, fn, fn, fn}
+	if AllSomeLimit(context.Background(), 2, fns...).IsNone() {
+		t.Fatal("AllSomeLimit should be Some")
+	}
+	if p := peak.Load(); p > 2 {
+		t.Fatalf("peak concurrency = %d; want <= 2", p)
+	}
+
+	before := runtime.NumGoroutine()
+	var most atomic.Int32
+	count := func(ctx context.Context) Option[int] {
+		for {
+			n, m := int32(runtime.NumGoroutine()-before), most.Load()
+			if n <= m || most.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		return Some(1)
+	}
+	many := make([]func(context.Context) Option[int], 200)
+	for i := range many {
+		many[i] = count
+	}
+	if AllSomeLimit(context.Background(), 3, many...).IsNone() {
+		t.Fatal("AllSomeLimit with 200 functions should be Some")
+	}
+	if m := most.Load(); m > 10 {
+		t.Fatalf("AllSomeLimit(3) started %d extra goroutines; want a handful", m)
+	}
+
+	// Once ctx is done, functions that have not started are skipped and count as None.
+	ctx, cancel := context.WithCancel(context.Background())
+	var ran atomic.Int32
+	stop := func(context.Context) Option[int] {
+		ran.Add(1)
+		cancel()
+		return Some(1)
+	}
+	next := func(context.Context) Option[int] {
+		ran.Add(1)
+		return Some(2)
+	}
+	if AllSomeLimit(ctx, 1, stop, next, next).IsSome() {
+		t.Fatal("AllSomeLimit with skipped functions should be None")
+	}
+	if n := ran.Load(); n != 1 {
+		t.Fatalf("functions run after cancel = %d; want 1", n)
+	}
+}
+
+func TestFirstSome(t *testing.T) {
+	ctx := context.Background()
+	v := FirstSome(ctx, valueAfter(time.Second, Some("slow")), valueAfter(10*time.Millisecond, Some("fast")), valueAfter(0, None[string]()))
+	if v.UnwrapOr("") != "fast" {
+		t.Fatalf("FirstSome = %v; want Some(fast)", v)
+	}
+	if FirstSome(ctx, valueAfter(0, None[int]()), valueAfter(0, None[int]())).IsSome() {
+		t.Fatal("FirstSome with all None should be None")
+	}
+	if v := FirstSomeLimit(ctx, 1, valueAfter(0, None[int]()), valueAfter(0, Some(2))); v.UnwrapOr(0) != 2 {
+		t.Fatalf("FirstSomeLimit = %v; want Some(2)", v)
+	}
+
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	if FirstSome(cctx, valueAfter(0, Some(1))).IsSome() {
+		t.Fatal("FirstSome on a cancelled context should be None")
+	}
+}
+
+func TestQuorum(t *testing.T) {
+	ctx := context.Background()
+	v := Quorum(ctx, 2, valueAfter(0, Some(1)), valueAfter(time.Second, Some(2)), valueAfter(10*time.Millisecond, Some(3)))
+	if got := v.UnwrapOr(nil); !slices.Equal(got, []int{1, 3}) {
+		t.Fatalf("Quorum(2) = %v; want Some([1 3])", v)
+	}
+	start := time.Now()
+	if Quorum(ctx, 2, valueAfter(0, None[int]()), valueAfter(0, None[int]()), valueAfter(time.Second, Some(3))).IsSome() {
+		t.Fatal("Quorum(2) with two None of three should be None")
+	}
+	if time.Since(start) > 500*time.Millisecond {
+		t.Fatal("Quorum should give up once the quorum is unreachable")
+	}
+	if Quorum(ctx, 3, valueAfter(0, Some(1))).IsSome() {
+		t.Fatal("Quorum(n > len(fns)) should be None")
+	}
+	if v := Quorum[int](ctx, 0); v.IsNone() {
+		t.Fatal("Quorum(0) should be Some([])")
+	}
+	if v := QuorumLimit(ctx, 2, 1, valueAfter(0, Some(1)), valueAfter(0, Some(2))); len(v.UnwrapOr(nil)) != 2 {
+		t.Fatalf("QuorumLimit = %v; want two values", v)
+	}
+}
+
+func TestConcurrentPanic(t *testing.T) {
+	boom := errors.New("boom")
+	defer func() {
+		pe, ok := recover().(*PanicError)
+		if !ok || !errors.Is(pe, boom) || len(pe.Stack) == 0 {
+			t.Fatalf("recovered %v; want *PanicError wrapping boom", pe)
+		}
+	}()
+	AllSome(context.Background(),
+		valueAfter(time.Second, Some(1)),
+		func(context.Context) Option[int] { panic(boom) },
+	)
+	t.Fatal("AllSome should re-raise the panic")
+}
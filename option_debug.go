@@ -0,0 +1,46 @@
+//go:build gopt_debug
+
+package gopt
+
+import (
+	"path"
+	"runtime"
+	"strings"
+)
+
+// noneSource is where a None was created. With the gopt_debug tag it points at the
+// recorded call site; nil means unknown (e.g. the zero value of Option).
+type noneSource struct {
+	loc *Location
+}
+
+// pkgDir is the directory of this package's sources; frames in it are skipped so that the
+// recorded location is the caller of gopt, not gopt itself.
+var pkgDir = func() string {
+	_, file, _, _ := runtime.Caller(0)
+	return path.Dir(file)
+}()
+
+// newNoneSource records the first caller outside this package. Test files of the package
+// count as callers.
+func newNoneSource() noneSource {
+	var pcs [32]uintptr
+	n := runtime.Callers(2, pcs[:])
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		f, more := frames.Next()
+		if path.Dir(f.File) != pkgDir || strings.HasSuffix(f.File, "_test.go") {
+			return noneSource{loc: &Location{File: f.File, Line: f.Line, Func: f.Function}}
+		}
+		if !more {
+			return noneSource{}
+		}
+	}
+}
+
+func (s noneSource) location() Option[Location] {
+	if s.loc == nil {
+		return Option[Location]{}
+	}
+	return Some(*s.loc)
+}
@@ -0,0 +1,94 @@
+//go:build gopt_debug
+
+package gopt
+
+import (
+	"errors"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// line returns the line number of its caller.
+func line() int {
+	_, _, l, _ := runtime.Caller(1)
+	return l
+}
+
+func checkOrigin[T any](t *testing.T, name string, o Option[T], wantLine int) {
+	t.Helper()
+	loc, ok := Origin(o).Get()
+	if !ok {
+		t.Fatalf("Origin(%s) = None; want line %d", name, wantLine)
+	}
+	if loc.Line != wantLine || !strings.HasSuffix(loc.File, "option_debug_test.go") {
+		t.Fatalf("Origin(%s) = %v; want option_debug_test.go:%d", name, loc, wantLine)
+	}
+	if !strings.HasPrefix(loc.Func, "github.com/kxrxh/gopt.TestOrigin") {
+		t.Fatalf("Origin(%s).Func = %q; want a TestOrigin function", name, loc.Func)
+	}
+}
+
+func TestOrigin(t *testing.T) {
+	o, l := None[int](), line()
+	checkOrigin(t, "None", o, l)
+	o, l = Try(strconv.Atoi("x")), line()
+	checkOrigin(t, "Try", o, l)
+	o, l = FromPtr[int](nil), line()
+	checkOrigin(t, "FromPtr", o, l)
+	o, l = Some(3).Filter(func(x int) bool { return x > 5 }), line()
+	checkOrigin(t, "Filter", o, l)
+	o, l = Div(Some(1), Some(0)), line()
+	checkOrigin(t, "Div", o, l)
+	o, l = Lookup(map[string]int{}, "k"), line()
+	checkOrigin(t, "Lookup", o, l)
+
+	if Origin(Some(1)).IsSome() {
+		t.Fatal("Origin(Some) should be None")
+	}
+	var zero Option[int]
+	if Origin(zero).IsSome() {
+		t.Fatal("Origin(zero value) should be None")
+	}
+}
+
+func TestOriginPropagates(t *testing.T) {
+	failed, l := Try(strconv.Atoi("x")), line()
+	double := func(x int) int { return x * 2 }
+	chain := AndThen(Map(failed, double), func(x int) Option[string] { return Some(strconv.Itoa(x)) })
+	checkOrigin(t, "AndThen(Map(Try))", chain, l)
+	checkOrigin(t, "Zip", Zip(Some(1), failed), l)
+	checkOrigin(t, "Map3", Map3(Some(1), failed, None[int](), func(a, b, c int) int { return a }), l)
+	checkOrigin(t, "Sum", Sum([]Option[int]{Some(1), failed}, PropagateNone), l)
+	checkOrigin(t, "Flatten", Flatten(Some(failed)), l)
+	checkOrigin(t, "Do", Do(func(b *Binder) int { return Bind(b, failed) }), l)
+	allNone := []Option[int]{{}, failed, None[int]()}
+	checkOrigin(t, "Min", Min(allNone, SkipNone), l)
+	checkOrigin(t, "Max", Max(allNone, SkipNone), l)
+	checkOrigin(t, "Reduce", Reduce(allNone, func(a, b int) int { return a + b }), l)
+
+	o, l2 := Min([]Option[int]{}, SkipNone), line()
+	checkOrigin(t, "Min(empty)", o, l2)
+	o, l2 = Max([]Option[int]{{}}, SkipNone), line()
+	checkOrigin(t, "Max(zero values)", o, l2)
+	o, l2 = Reduce([]Option[int]{}, func(a, b int) int { return a + b }), line()
+	checkOrigin(t, "Reduce(empty)", o, l2)
+}
+
+func TestUnwrapErrorOrigin(t *testing.T) {
+	o, l := Try(strconv.Atoi("x")), line()
+	defer func() {
+		var ue *UnwrapError
+		if e, ok := recover().(error); !ok || !errors.As(e, &ue) {
+			t.Fatal("Unwrap should panic with *UnwrapError")
+		}
+		if loc, ok := ue.Origin.Get(); !ok || loc.Line != l {
+			t.Fatalf("UnwrapError.Origin = %v; want line %d", ue.Origin, l)
+		}
+		if want := "created at "; !strings.Contains(ue.Error(), want) {
+			t.Fatalf("Error() = %q; want it to contain %q", ue.Error(), want)
+		}
+	}()
+	o.Unwrap()
+}
@@ -0,0 +1,61 @@
+package gopt
+
+// Binder is the handle passed to a Do block. Use it with Bind to extract values;
+// it must not be used after the block returns or from other goroutines.
+type Binder struct {
+	done bool
+}
+
+// bindAbort is the private panic value Bind uses to unwind to its Do block.
+type bindAbort struct {
+	b   *Binder
+	src noneSource // origin of the None that stopped the block
+}
+
+// Do runs fn and returns Some(result). Inside fn, Bind(b, opt) returns the value of opt or,
+// if opt is None, unwinds the whole block so that Do returns None. This keeps long chains
+// readable when later steps need earlier values.
+//
+// Unwinding uses a private panic that Do recovers; any other panic propagates unchanged.
+// Deferred functions inside fn run during the unwind. Do blocks may be nested: Bind on an
+// outer Binder inside an inner block unwinds to the outer block.
+// A block that reaches None costs a panic and recover (hundreds of nanoseconds);
+// prefer AndThen in hot loops.
+//
+// Example:
+//
+//	o := Do(func(b *Binder) string {
+//		user := Bind(b, findUser(id))
+//		org := Bind(b, findOrg(user.OrgID))
+//		return user.Name + "@" + org.Name
+//	})  // None if either lookup is None
+func Do[T any](fn func(b *Binder) T) (result Option[T]) {
+	b := &Binder{}
+	defer func() {
+		b.done = true
+		if r := recover(); r != nil {
+			if a, ok := r.(bindAbort); ok && a.b == b {
+				result = Option[T]{src: a.src}
+				return
+			}
+			panic(r)
+		}
+	}()
+	return Some(fn(b))
+}
+
+// Bind returns the value of o if Some. If o is None, it unwinds the Do block that owns b,
+// which then returns None. It panics if b's block has already returned.
+//
+// Example:
+//
+//	n := Bind(b, Try(strconv.Atoi(s)))
+func Bind[T any](b *Binder, o Option[T]) T {
+	if b.done {
+		panic("gopt: Bind called after its Do block returned")
+	}
+	if !o.ok {
+		panic(bindAbort{b: b, src: o.src})
+	}
+	return o.value
+}
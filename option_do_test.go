@@ -0,0 +1,79 @@
+package gopt
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestDo(t *testing.T) {
+	parse := func(s string) Option[int] { return Try(strconv.Atoi(s)) }
+	sum := func(a, b string) Option[int] {
+		return Do(func(bd *Binder) int {
+			return Bind(bd, parse(a)) + Bind(bd, parse(b))
+		})
+	}
+	if v := sum("1", "2"); v.Unwrap() != 3 {
+		t.Fatalf("Do with all Some = %v; want Some(3)", v)
+	}
+	if sum("1", "x").IsSome() || sum("x", "2").IsSome() {
+		t.Fatal("Do with a None should be None")
+	}
+}
+
+func TestDoShortCircuits(t *testing.T) {
+	steps := 0
+	deferred := false
+	o := Do(func(b *Binder) int {
+		defer func() { deferred = true }()
+		steps++
+		Bind(b, None[int]())
+		steps++
+		return 0
+	})
+	if o.IsSome() || steps != 1 || !deferred {
+		t.Fatalf("Do = %v, steps = %d, deferred = %v; want None, 1, true", o, steps, deferred)
+	}
+}
+
+func TestDoPropagatesPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Fatalf("recovered %v; want \"boom\"", r)
+		}
+	}()
+	Do(func(b *Binder) int { panic("boom") })
+}
+
+func TestDoNested(t *testing.T) {
+	innerRan := false
+	o := Do(func(outer *Binder) int {
+		in := Do(func(inner *Binder) int {
+			innerRan = true
+			return Bind(outer, None[int]())
+		})
+		t.Fatalf("outer Bind should unwind past the inner block; inner = %v", in)
+		return 0
+	})
+	if o.IsSome() || !innerRan {
+		t.Fatal("outer block should return None")
+	}
+
+	o = Do(func(outer *Binder) int {
+		in := Do(func(inner *Binder) int { return Bind(inner, None[int]()) })
+		return in.UnwrapOr(7)
+	})
+	if o.Unwrap() != 7 {
+		t.Fatalf("inner Bind should only unwind the inner block; got %v", o)
+	}
+}
+
+func TestBindAfterDo(t *testing.T) {
+	var leaked *Binder
+	Do(func(b *Binder) int { leaked = b; return 0 })
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("Bind after Do returned should panic")
+		}
+	}()
+	Bind(leaked, Some(1))
+}
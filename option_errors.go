@@ -0,0 +1,223 @@
+package gopt
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"runtime/debug"
+	"sync/atomic"
+)
+
+// ErrNone is the error reported when a value is required but the option is None.
+// TryUnwrap returns it, and it can be matched with errors.Is.
+var ErrNone = errors.New("gopt: option is None")
+
+// TryUnwrap returns the contained value, or ErrNone if the option is None.
+// Use it at service boundaries instead of Unwrap or Expect.
+//
+// Example:
+//
+//	v, err := None[int]().TryUnwrap()  // 0, ErrNone
+func (o Option[T]) TryUnwrap() (T, error) {
+	if !o.ok {
+		return o.value, ErrNone
+	}
+	return o.value, nil
+}
+
+// OkOr returns (value, nil) if o is Some, otherwise (zero, err).
+//
+// Example:
+//
+//	user, err := OkOr(findUser(id), ErrUserNotFound)
+func OkOr[T any](o Option[T], err error) (T, error) {
+	if !o.ok {
+		return o.value, err
+	}
+	return o.value, nil
+}
+
+// OkOrElse returns (value, nil) if o is Some, otherwise (zero, fn()).
+// fn is only called when o is None.
+//
+// Example:
+//
+//	user, err := OkOrElse(findUser(id), func() error { return fmt.Errorf("user %d: %w", id, ErrNone) })
+func OkOrElse[T any](o Option[T], fn func() error) (T, error) {
+	if !o.ok {
+		return o.value, fn()
+	}
+	return o.value, nil
+}
+
+// AsError returns Some(target) if errors.As finds an error of type E in err's chain,
+// otherwise None.
+//
+// Example:
+//
+//	if pe, ok := AsError[*fs.PathError](err).Get(); ok { log.Println(pe.Path) }
+func AsError[E error](err error) Option[E] {
+	var target E
+	if errors.As(err, &target) {
+		return Some(target)
+	}
+	return None[E]()
+}
+
+// TryFunc calls fn and returns Some(v) if it returns a nil error, otherwise None.
+// It is Try for functions, convenient when the call is not already in (T, error) form at the call site.
+//
+// Example:
+//
+//	o := TryFunc(func() (int, error) { return strconv.Atoi(s) })
+func TryFunc[T any](fn func() (T, error)) Option[T] {
+	return Try(fn())
+}
+
+// Catch calls fn and returns Some(result). If fn panics, the panic is recovered and Catch returns None.
+//
+// Example:
+//
+//	o := Catch(func() int { return m["k"].(int) })  // None if the assertion panics
+func Catch[T any](fn func() T) (result Option[T]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = None[T]()
+		}
+	}()
+	return Some(fn())
+}
+
+// PanicError wraps a value recovered from a panic, with the stack at the point of recovery.
+// If the panic value is an error, it is available through errors.Is and errors.As.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("gopt: panic: %v", e.Value)
+}
+
+// Unwrap returns the panic value if it is an error, otherwise nil.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// CatchErr calls fn and returns its result. If fn panics, the panic is recovered and
+// returned as a *PanicError, so that a panic through Unwrap or Expect becomes an ordinary error.
+//
+// Example:
+//
+//	v, err := CatchErr(func() int { return cfg.Port.Expect("port is required") })
+func CatchErr[T any](fn func() T) (v T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = &PanicError{Value: r, Stack: debug.Stack()}
+		}
+	}()
+	return fn(), nil
+}
+
+// UnwrapError is the panic value of Unwrap, Expect, Expectf and UnwrapOrPanicWith on None.
+// It records the element type and the location of the failing call, and matches ErrNone
+// with errors.Is, so recover middleware can tell it apart from other panics:
+//
+//	if r := recover(); r != nil {
+//		if ue, ok := r.(*gopt.UnwrapError); ok { log.Printf("%s at %s:%d", ue.Type, ue.File, ue.Line) }
+//	}
+type UnwrapError struct {
+	Type reflect.Type // element type of the option
+	Msg  string       // message passed to Expect or Expectf; empty for Unwrap
+	Err  error        // error passed to UnwrapOrPanicWith; nil otherwise
+	File string       // file of the failing call
+	Line int          // line of the failing call
+	Func string       // fully qualified name of the function making the call
+
+	// Origin is where the option became None; see Origin. It is only recorded in
+	// builds with the gopt_debug tag and is None otherwise.
+	Origin Option[Location]
+}
+
+func (e *UnwrapError) Error() string {
+	what := "Unwrap called on None"
+	switch {
+	case e.Err != nil:
+		what = e.Err.Error()
+	case e.Msg != "":
+		what = e.Msg
+	}
+	if loc, ok := e.Origin.Get(); ok {
+		return fmt.Sprintf("gopt: %s (None[%v] at %s:%d, created at %s)", what, e.Type, e.File, e.Line, loc)
+	}
+	return fmt.Sprintf("gopt: %s (None[%v] at %s:%d)", what, e.Type, e.File, e.Line)
+}
+
+// Unwrap returns ErrNone and, for UnwrapOrPanicWith, the error it was given.
+func (e *UnwrapError) Unwrap() []error {
+	if e.Err != nil {
+		return []error{ErrNone, e.Err}
+	}
+	return []error{ErrNone}
+}
+
+var unwrapHook atomic.Pointer[func(*UnwrapError)]
+
+// SetUnwrapHook installs fn to be called, process-wide, with the *UnwrapError just before
+// an unwrap of None panics; use it for logging or metrics. Pass nil to remove the hook.
+// fn runs on the panicking goroutine and must be safe for concurrent use.
+//
+// Example:
+//
+//	SetUnwrapHook(func(e *UnwrapError) { unwrapFailures.Inc(); log.Print(e) })
+func SetUnwrapHook(fn func(*UnwrapError)) {
+	if fn == nil {
+		unwrapHook.Store(nil)
+		return
+	}
+	unwrapHook.Store(&fn)
+}
+
+// unwrapPanic panics with an *UnwrapError for element type T, attributed to the caller of
+// the method that called unwrapPanic. src is the origin of the None, if known.
+func unwrapPanic[T any](src noneSource, msg string, cause error) {
+	e := &UnwrapError{Type: typeOf[T](), Msg: msg, Err: cause, Origin: src.location()}
+	if pc, file, line, ok := runtime.Caller(2); ok {
+		e.File, e.Line = file, line
+		if fn := runtime.FuncForPC(pc); fn != nil {
+			e.Func = fn.Name()
+		}
+	}
+	if hook := unwrapHook.Load(); hook != nil {
+		(*hook)(e)
+	}
+	panic(e)
+}
+
+// Expectf is like Expect with a fmt.Sprintf-style message. The message is only
+// formatted when the option is None.
+//
+// Example:
+//
+//	port := cfg.Port.Expectf("port for %s is required", name)
+func (o Option[T]) Expectf(format string, args ...any) T {
+	if !o.ok {
+		unwrapPanic[T](o.src, fmt.Sprintf(format, args...), nil)
+	}
+	return o.value
+}
+
+// UnwrapOrPanicWith returns the contained value if Some. If None, it panics with an
+// *UnwrapError that wraps err, so both errors.Is(p, err) and errors.Is(p, ErrNone) hold.
+//
+// Example:
+//
+//	user := findUser(id).UnwrapOrPanicWith(ErrUserNotFound)
+func (o Option[T]) UnwrapOrPanicWith(err error) T {
+	if !o.ok {
+		unwrapPanic[T](o.src, "", err)
+	}
+	return o.value
+}
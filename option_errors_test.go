@@ -0,0 +1,147 @@
+package gopt
+
+import (
+	"errors"
+	"io/fs"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var errTest = errors.New("test error")
+
+func TestTryUnwrap(t *testing.T) {
+	if v, err := Some(1).TryUnwrap(); v != 1 || err != nil {
+		t.Fatalf("Some(1).TryUnwrap() = %v, %v; want 1, nil", v, err)
+	}
+	if _, err := None[int]().TryUnwrap(); !errors.Is(err, ErrNone) {
+		t.Fatalf("None.TryUnwrap() err = %v; want ErrNone", err)
+	}
+}
+
+func TestOkOr(t *testing.T) {
+	if v, err := OkOr(Some(1), errTest); v != 1 || err != nil {
+		t.Fatalf("OkOr(Some(1)) = %v, %v; want 1, nil", v, err)
+	}
+	if _, err := OkOr(None[int](), errTest); err != errTest {
+		t.Fatalf("OkOr(None) err = %v; want errTest", err)
+	}
+	calls := 0
+	fn := func() error { calls++; return errTest }
+	if _, err := OkOrElse(Some(1), fn); err != nil || calls != 0 {
+		t.Fatal("OkOrElse(Some) should not call fn")
+	}
+	if _, err := OkOrElse(None[int](), fn); err != errTest || calls != 1 {
+		t.Fatalf("OkOrElse(None) err = %v; want errTest", err)
+	}
+}
+
+func TestAsError(t *testing.T) {
+	pe := &fs.PathError{Op: "open", Path: "/x", Err: fs.ErrNotExist}
+	wrapped := errors.Join(errTest, pe)
+	if got := AsError[*fs.PathError](wrapped); got.Unwrap() != pe {
+		t.Fatalf("AsError = %v; want Some(pe)", got)
+	}
+	if AsError[*fs.PathError](errTest).IsSome() || AsError[*fs.PathError](nil).IsSome() {
+		t.Fatal("AsError without a match should be None")
+	}
+}
+
+func TestTryFunc(t *testing.T) {
+	if TryFunc(func() (int, error) { return strconv.Atoi("4") }).Unwrap() != 4 {
+		t.Fatal("TryFunc with nil error should be Some")
+	}
+	if TryFunc(func() (int, error) { return strconv.Atoi("x") }).IsSome() {
+		t.Fatal("TryFunc with error should be None")
+	}
+}
+
+func TestCatch(t *testing.T) {
+	if Catch(func() int { return 1 }).Unwrap() != 1 {
+		t.Fatal("Catch without panic should be Some")
+	}
+	if Catch(func() int { return None[int]().Unwrap() }).IsSome() {
+		t.Fatal("Catch with panic should be None")
+	}
+
+	if v, err := CatchErr(func() int { return 2 }); v != 2 || err != nil {
+		t.Fatalf("CatchErr without panic = %v, %v; want 2, nil", v, err)
+	}
+	_, err := CatchErr(func() int { panic(errTest) })
+	var pe *PanicError
+	if !errors.As(err, &pe) || !errors.Is(err, errTest) || len(pe.Stack) == 0 {
+		t.Fatalf("CatchErr with panic err = %v; want *PanicError wrapping errTest", err)
+	}
+	if _, err := CatchErr(func() int { panic("text") }); err == nil || errors.Unwrap(err) != nil {
+		t.Fatalf("CatchErr with non-error panic = %v; want *PanicError with no wrapped error", err)
+	}
+}
+
+func recoverUnwrapError(t *testing.T, fn func()) (ue *UnwrapError) {
+	t.Helper()
+	defer func() {
+		r := recover()
+		var ok bool
+		if ue, ok = r.(*UnwrapError); !ok {
+			t.Fatalf("recovered %#v; want *UnwrapError", r)
+		}
+	}()
+	fn()
+	return nil
+}
+
+func TestUnwrapError(t *testing.T) {
+	ue := recoverUnwrapError(t, func() { None[int]().Unwrap() })
+	if ue.Type.String() != "int" || ue.Msg != "" || ue.Line == 0 || !strings.HasSuffix(ue.File, "option_errors_test.go") {
+		t.Fatalf("Unwrap panic = %+v; want int type and caller location", ue)
+	}
+	if !strings.Contains(ue.Func, "TestUnwrapError") {
+		t.Fatalf("Func = %q; want the calling test", ue.Func)
+	}
+	if !errors.Is(ue, ErrNone) {
+		t.Fatal("UnwrapError should match ErrNone")
+	}
+
+	ue = recoverUnwrapError(t, func() { None[error]().Expect("need it") })
+	if ue.Type.String() != "error" || ue.Msg != "need it" || !strings.Contains(ue.Error(), "need it") {
+		t.Fatalf("Expect panic = %+v (%v); want error type and message", ue, ue)
+	}
+
+	ue = recoverUnwrapError(t, func() { None[string]().Expectf("port for %s", "api") })
+	if ue.Msg != "port for api" {
+		t.Fatalf("Expectf message = %q; want \"port for api\"", ue.Msg)
+	}
+
+	ue = recoverUnwrapError(t, func() { None[int]().UnwrapOrPanicWith(errTest) })
+	if !errors.Is(ue, errTest) || !errors.Is(ue, ErrNone) {
+		t.Fatalf("UnwrapOrPanicWith panic = %v; want to match errTest and ErrNone", ue)
+	}
+
+	if ue = recoverUnwrapError(t, func() { NonePtr[int]().Unwrap() }); ue.Type.String() != "*int" {
+		t.Fatalf("Ptr Unwrap type = %v; want *int", ue.Type)
+	}
+	if ue = recoverUnwrapError(t, func() { NoneSentinel[int32]().Expect("x") }); ue.Type.String() != "int32" {
+		t.Fatalf("Sentinel Expect type = %v; want int32", ue.Type)
+	}
+
+	if Some(1).Expectf("unused %d", 1) != 1 || Some(1).UnwrapOrPanicWith(errTest) != 1 {
+		t.Fatal("Expectf/UnwrapOrPanicWith on Some should return the value")
+	}
+}
+
+func TestSetUnwrapHook(t *testing.T) {
+	var got *UnwrapError
+	SetUnwrapHook(func(e *UnwrapError) { got = e })
+	t.Cleanup(func() { SetUnwrapHook(nil) })
+
+	ue := recoverUnwrapError(t, func() { None[int]().Unwrap() })
+	if got != ue {
+		t.Fatal("hook should receive the panic value before the panic")
+	}
+	SetUnwrapHook(nil)
+	got = nil
+	recoverUnwrapError(t, func() { None[int]().Unwrap() })
+	if got != nil {
+		t.Fatal("hook should not run after being removed")
+	}
+}
@@ -0,0 +1,159 @@
+package gopt
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// String returns "Some(v)" with v formatted by %v, or "None".
+//
+// Example:
+//
+//	Some(42).String()     // "Some(42)"
+//	None[int]().String()  // "None"
+func (o Option[T]) String() string {
+	return fmt.Sprint(o)
+}
+
+// GoString returns o as Go syntax, used by the %#v verb.
+//
+// Example:
+//
+//	Some(42).GoString()     // "gopt.Some[int](42)"
+//	None[int]().GoString()  // "gopt.None[int]()"
+func (o Option[T]) GoString() string {
+	typ := typeOf[T]()
+	if !o.ok {
+		return fmt.Sprintf("gopt.None[%s]()", typ)
+	}
+	return fmt.Sprintf("gopt.Some[%s](%#v)", typ, o.value)
+}
+
+// Format implements fmt.Formatter. Some(v) prints as "Some(" + v + ")" where v is formatted
+// with the same verb, flags, width and precision, so %.2f, %q and %+v apply to the value;
+// None prints as "None". %#v prints the GoString form.
+//
+// Example:
+//
+//	fmt.Sprintf("%.2f", Some(3.14159))  // "Some(3.14)"
+//	fmt.Sprintf("%q", Some("a"))        // `Some("a")`
+//	fmt.Sprintf("%v", Some(Some(1)))    // "Some(Some(1))"
+func (o Option[T]) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		io.WriteString(f, o.GoString())
+		return
+	}
+	if !o.ok {
+		io.WriteString(f, "None")
+		return
+	}
+	io.WriteString(f, "Some(")
+	fmt.Fprintf(f, fmt.FormatString(f, verb), o.value)
+	io.WriteString(f, ")")
+}
+
+// Format implements fmt.Formatter. The pair prints as "(first, second)" with each value
+// formatted using the same verb and flags; %#v prints Go syntax.
+//
+// Example:
+//
+//	fmt.Sprint(Pair[int, string]{1, "a"})  // "(1, a)"
+func (p Pair[A, B]) Format(f fmt.State, verb rune) {
+	formatTuple(f, verb, p, p.First, p.Second)
+}
+
+// Format implements fmt.Formatter like Pair's Format.
+//
+// Example:
+//
+//	fmt.Sprint(Triple[int, int, int]{1, 2, 3})  // "(1, 2, 3)"
+func (t Triple[A, B, C]) Format(f fmt.State, verb rune) {
+	formatTuple(f, verb, t, t.First, t.Second, t.Third)
+}
+
+// Format implements fmt.Formatter like Pair's Format.
+//
+// Example:
+//
+//	fmt.Sprint(Quad[int, int, int, int]{1, 2, 3, 4})  // "(1, 2, 3, 4)"
+func (q Quad[A, B, C, D]) Format(f fmt.State, verb rune) {
+	formatTuple(f, verb, q, q.First, q.Second, q.Third, q.Fourth)
+}
+
+// Format implements fmt.Formatter like Pair's Format.
+//
+// Example:
+//
+//	fmt.Sprint(Quint[int, int, int, int, int]{1, 2, 3, 4, 5})  // "(1, 2, 3, 4, 5)"
+func (q Quint[A, B, C, D, E]) Format(f fmt.State, verb rune) {
+	formatTuple(f, verb, q, q.First, q.Second, q.Third, q.Fourth, q.Fifth)
+}
+
+// Format implements fmt.Formatter. Either prints as "Left(v)" or "Right(v)" with v formatted
+// using the same verb and flags; %#v prints Go syntax.
+//
+// Example:
+//
+//	fmt.Sprint(Right[int]("x"))  // "Right(x)"
+func (e Either[L, R]) Format(f fmt.State, verb rune) {
+	side, v := "Left", any(e.left)
+	if e.isRight {
+		side, v = "Right", e.right
+	}
+	if verb == 'v' && f.Flag('#') {
+		fmt.Fprintf(f, "gopt.%s[%s, %s](%#v)", side, typeOf[L](), typeOf[R](), v)
+		return
+	}
+	io.WriteString(f, side+"(")
+	fmt.Fprintf(f, fmt.FormatString(f, verb), v)
+	io.WriteString(f, ")")
+}
+
+// formatTuple writes vals as "(a, b, ...)" using verb, or as a Go composite literal of
+// self's type for %#v.
+func formatTuple(f fmt.State, verb rune, self any, vals ...any) {
+	if verb == 'v' && f.Flag('#') {
+		fmt.Fprintf(f, "%s{", reflect.TypeOf(self))
+		for i, v := range vals {
+			if i > 0 {
+				io.WriteString(f, ", ")
+			}
+			fmt.Fprintf(f, "%s:%#v", tupleFields[i], v)
+		}
+		io.WriteString(f, "}")
+		return
+	}
+	format := fmt.FormatString(f, verb)
+	io.WriteString(f, "(")
+	for i, v := range vals {
+		if i > 0 {
+			io.WriteString(f, ", ")
+		}
+		fmt.Fprintf(f, format, v)
+	}
+	io.WriteString(f, ")")
+}
+
+// typeOf returns the reflect.Type of T; unlike reflect.TypeOf it works for interface types.
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+// Format implements fmt.Formatter like Option's Format: "Some(pointer)" or "None".
+//
+// Example:
+//
+//	fmt.Sprint(PtrOf(&user))  // "Some(&{...})"
+func (p Ptr[T]) Format(f fmt.State, verb rune) {
+	p.ToOption().Format(f, verb)
+}
+
+// Format implements fmt.Formatter like Option's Format: "Some(v)" or "None".
+//
+// Example:
+//
+//	fmt.Sprint(NoneSentinel[int]())  // "None"
+func (s Sentinel[T]) Format(f fmt.State, verb rune) {
+	s.ToOption().Format(f, verb)
+}
@@ -0,0 +1,74 @@
+package gopt
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	type point struct{ X, Y int }
+	cases := []struct {
+		format string
+		arg    any
+		want   string
+	}{
+		{"%v", Some(42), "Some(42)"},
+		{"%v", None[int](), "None"},
+		{"%d", None[int](), "None"},
+		{"%.2f", Some(3.14159), "Some(3.14)"},
+		{"%6.1f", Some(2.0), "Some(   2.0)"},
+		{"%q", Some("a"), `Some("a")`},
+		{"%x", Some(255), "Some(ff)"},
+		{"%+v", Some(point{1, 2}), "Some({X:1 Y:2})"},
+		{"%v", Some(Some(1)), "Some(Some(1))"},
+		{"%v", Some(None[int]()), "Some(None)"},
+		{"%v", []Option[int]{Some(1), None[int]()}, "[Some(1) None]"},
+		{"%#v", Some(42), "gopt.Some[int](42)"},
+		{"%#v", None[string](), "gopt.None[string]()"},
+		{"%#v", Some(Some("a")), `gopt.Some[gopt.Option[string]](gopt.Some[string]("a"))`},
+		{"%v", Pair[int, string]{1, "a"}, "(1, a)"},
+		{"%q", Pair[string, string]{"a", "b"}, `("a", "b")`},
+		{"%v", Some(Pair[int, Option[int]]{1, None[int]()}), "Some((1, None))"},
+		{"%#v", Pair[int, string]{1, "a"}, `gopt.Pair[int,string]{First:1, Second:"a"}`},
+		{"%v", Triple[int, int, int]{1, 2, 3}, "(1, 2, 3)"},
+		{"%v", Right[int]("x"), "Right(x)"},
+		{"%.1f", Left[float64, string](1.25), "Left(1.2)"},
+		{"%#v", Left[int, string](1), "gopt.Left[int, string](1)"},
+	}
+	for _, c := range cases {
+		if got := fmt.Sprintf(c.format, c.arg); got != c.want {
+			t.Fatalf("Sprintf(%q, ...) = %q; want %q", c.format, got, c.want)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	if got := Some(42).String(); got != "Some(42)" {
+		t.Fatalf("Some(42).String() = %q; want %q", got, "Some(42)")
+	}
+	if got := None[int]().String(); got != "None" {
+		t.Fatalf("None.String() = %q; want %q", got, "None")
+	}
+	var s fmt.Stringer = Some("x")
+	if got := fmt.Sprint(s); got != "Some(x)" {
+		t.Fatalf("Sprint(Stringer) = %q; want %q", got, "Some(x)")
+	}
+	var any1 Option[any] = Some[any](nil)
+	if got := any1.GoString(); got != "gopt.Some[interface {}](<nil>)" {
+		t.Fatalf("Some[any](nil).GoString() = %q", got)
+	}
+}
+
+func TestFormatPtrSentinel(t *testing.T) {
+	x := 7
+	if got := fmt.Sprintf("%v", PtrOf(&x)); got != fmt.Sprintf("Some(%v)", &x) {
+		t.Fatalf("Sprint(PtrOf(&x)) = %q", got)
+	}
+	if got := fmt.Sprint(NonePtr[int]()); got != "None" {
+		t.Fatalf("Sprint(NonePtr) = %q; want None", got)
+	}
+	s, _ := NewSentinel(3)
+	if got := fmt.Sprint(s, NoneSentinel[int]()); got != "Some(3) None" {
+		t.Fatalf("Sprint(Sentinel) = %q; want %q", got, "Some(3) None")
+	}
+}
@@ -1,5 +1,7 @@
 package gopt
 
+import "cmp"
+
 // Map transforms the contained value if o is Some by applying fn, otherwise returns None[U].
 //
 // Example:
@@ -7,7 +9,7 @@ package gopt
 //	o := Map(Some(21), func(x int) int { return x * 2 })  // Some(42)
 func Map[T, U any](o Option[T], fn func(T) U) Option[U] {
 	if !o.ok {
-		return None[U]()
+		return propagate[U](o)
 	}
 	return Some(fn(o.value))
 }
@@ -45,7 +47,7 @@ func MapOrElse[T, U any](o Option[T], defaultFn func() U, fn func(T) U) U {
 //	o := AndThen(Some(4), func(x int) Option[int] { return Some(x * x) })  // Some(16)
 func AndThen[T, U any](o Option[T], fn func(T) Option[U]) Option[U] {
 	if !o.ok {
-		return None[U]()
+		return propagate[U](o)
 	}
 	return fn(o.value)
 }
@@ -77,6 +79,21 @@ func OrElse[T any](o Option[T], fn func() Option[T]) Option[T] {
 	return o.OrElse(fn)
 }
 
+// AsMut returns Some(pointer to the value stored in o) if o is Some, otherwise None.
+// Unlike ToPointer, the pointer refers to the value inside o, so writes through it change o.
+// It is a function rather than a method because Option[T] cannot refer to Option[*T] in its method set.
+//
+// Example:
+//
+//	o := Some(1)
+//	AsMut(&o).Tap(func(p *int) { *p = 2 })  // o=Some(2)
+func AsMut[T any](o *Option[T]) Option[*T] {
+	if !o.ok {
+		return propagate[*T](*o)
+	}
+	return Some(&o.value)
+}
+
 // Flatten converts Option[Option[T]] to Option[T]: Some(Some(x)) -> Some(x), otherwise None.
 //
 // Example:
@@ -84,7 +101,7 @@ func OrElse[T any](o Option[T], fn func() Option[T]) Option[T] {
 //	o := Flatten(Some(Some(42)))  // Some(42)
 func Flatten[T any](o Option[Option[T]]) Option[T] {
 	if !o.ok {
-		return None[T]()
+		return propagate[T](o)
 	}
 	return o.value
 }
@@ -119,7 +136,7 @@ func Match[T, R any](o Option[T], onSome func(T) R, onNone func() R) R {
 //	o, err := TryMap(Some("42"), strconv.Atoi)  // Some(42), nil
 func TryMap[T, U any](o Option[T], fn func(T) (U, error)) (Option[U], error) {
 	if !o.ok {
-		return None[U](), nil
+		return propagate[U](o), nil
 	}
 	u, err := fn(o.value)
 	if err != nil {
@@ -163,7 +180,232 @@ type Pair[A, B any] struct {
 //	o := Zip(Some(1), Some("a"))  // Some(Pair{1, "a"})
 func Zip[T, U any](a Option[T], b Option[U]) Option[Pair[T, U]] {
 	if !a.ok || !b.ok {
-		return None[Pair[T, U]]()
+		return Option[Pair[T, U]]{src: firstSource(a.source(), b.source())}
 	}
 	return Some(Pair[T, U]{First: a.value, Second: b.value})
 }
+
+// Number is the set of integer and floating-point types accepted by the numeric helpers.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// NonePolicy selects how the aggregate helpers (Sum, Product, Min, Max, Avg) treat None elements.
+type NonePolicy int
+
+const (
+	// SkipNone ignores None elements and aggregates the Some values only.
+	SkipNone NonePolicy = iota
+	// PropagateNone makes the result None if any element is None.
+	PropagateNone
+)
+
+// Sum returns the sum of the Some values in opts. With SkipNone, None elements are
+// ignored and an empty or all-None input gives Some(0). With PropagateNone, any None gives None.
+//
+// Example:
+//
+//	Sum([]Option[int]{Some(1), None[int](), Some(2)}, SkipNone)       // Some(3)
+//	Sum([]Option[int]{Some(1), None[int](), Some(2)}, PropagateNone)  // None[int]()
+func Sum[N Number](opts []Option[N], policy NonePolicy) Option[N] {
+	var sum N
+	for _, o := range opts {
+		if o.ok {
+			sum += o.value
+		} else if policy == PropagateNone {
+			return propagate[N](o)
+		}
+	}
+	return Some(sum)
+}
+
+// Product returns the product of the Some values in opts. With SkipNone, None elements are
+// ignored and an empty or all-None input gives Some(1). With PropagateNone, any None gives None.
+//
+// Example:
+//
+//	Product([]Option[int]{Some(2), Some(3)}, SkipNone)  // Some(6)
+func Product[N Number](opts []Option[N], policy NonePolicy) Option[N] {
+	prod := N(1)
+	for _, o := range opts {
+		if o.ok {
+			prod *= o.value
+		} else if policy == PropagateNone {
+			return propagate[N](o)
+		}
+	}
+	return Some(prod)
+}
+
+// Min returns the smallest Some value in opts, or None if there are no Some values
+// (or, with PropagateNone, if any element is None).
+//
+// Example:
+//
+//	Min([]Option[int]{Some(3), None[int](), Some(1)}, SkipNone)  // Some(1)
+func Min[T cmp.Ordered](opts []Option[T], policy NonePolicy) Option[T] {
+	var best Option[T]
+	for _, o := range opts {
+		if !o.ok {
+			if policy == PropagateNone {
+				return o
+			}
+			continue
+		}
+		if !best.ok || o.value < best.value {
+			best = o
+		}
+	}
+	if !best.ok {
+		return noneFrom(opts)
+	}
+	return best
+}
+
+// Max returns the largest Some value in opts, or None if there are no Some values
+// (or, with PropagateNone, if any element is None).
+//
+// Example:
+//
+//	Max([]Option[int]{Some(3), None[int](), Some(1)}, SkipNone)  // Some(3)
+func Max[T cmp.Ordered](opts []Option[T], policy NonePolicy) Option[T] {
+	var best Option[T]
+	for _, o := range opts {
+		if !o.ok {
+			if policy == PropagateNone {
+				return o
+			}
+			continue
+		}
+		if !best.ok || o.value > best.value {
+			best = o
+		}
+	}
+	if !best.ok {
+		return noneFrom(opts)
+	}
+	return best
+}
+
+// Avg returns the arithmetic mean of the Some values in opts as a float64, or None if there
+// are no Some values (or, with PropagateNone, if any element is None).
+//
+// Example:
+//
+//	Avg([]Option[int]{Some(1), None[int](), Some(2)}, SkipNone)  // Some(1.5)
+func Avg[N Number](opts []Option[N], policy NonePolicy) Option[float64] {
+	var sum float64
+	n := 0
+	for _, o := range opts {
+		if o.ok {
+			sum += float64(o.value)
+			n++
+		} else if policy == PropagateNone {
+			return propagate[float64](o)
+		}
+	}
+	if n == 0 {
+		return None[float64]()
+	}
+	return Some(sum / float64(n))
+}
+
+// Add returns Some(a + b) if both are Some, otherwise None.
+//
+// Example:
+//
+//	Add(Some(1), Some(2))  // Some(3)
+func Add[N Number](a, b Option[N]) Option[N] {
+	if !a.ok || !b.ok {
+		return Option[N]{src: firstSource(a.source(), b.source())}
+	}
+	return Some(a.value + b.value)
+}
+
+// Sub returns Some(a - b) if both are Some, otherwise None.
+//
+// Example:
+//
+//	Sub(Some(5), Some(2))  // Some(3)
+func Sub[N Number](a, b Option[N]) Option[N] {
+	if !a.ok || !b.ok {
+		return Option[N]{src: firstSource(a.source(), b.source())}
+	}
+	return Some(a.value - b.value)
+}
+
+// Mul returns Some(a * b) if both are Some, otherwise None.
+//
+// Example:
+//
+//	Mul(Some(2), Some(3))  // Some(6)
+func Mul[N Number](a, b Option[N]) Option[N] {
+	if !a.ok || !b.ok {
+		return Option[N]{src: firstSource(a.source(), b.source())}
+	}
+	return Some(a.value * b.value)
+}
+
+// Div returns Some(a / b) if both are Some and b is not zero, otherwise None.
+// Dividing by zero gives None for floats as well as integers.
+//
+// Example:
+//
+//	Div(Some(6), Some(3))  // Some(2)
+//	Div(Some(6), Some(0))  // None[int]()
+func Div[N Number](a, b Option[N]) Option[N] {
+	if !a.ok || !b.ok {
+		return Option[N]{src: firstSource(a.source(), b.source())}
+	}
+	if b.value == 0 {
+		return None[N]()
+	}
+	return Some(a.value / b.value)
+}
+
+// Fold calls fn for each Some value in opts, in order, threading an accumulator that starts at init.
+// None elements are skipped.
+//
+// Example:
+//
+//	Fold([]Option[int]{Some(1), None[int](), Some(2)}, "", func(acc string, x int) string {
+//		return acc + strconv.Itoa(x)
+//	})  // "12"
+func Fold[T, A any](opts []Option[T], init A, fn func(A, T) A) A {
+	acc := init
+	for _, o := range opts {
+		if o.ok {
+			acc = fn(acc, o.value)
+		}
+	}
+	return acc
+}
+
+// Reduce combines the Some values in opts with fn, left to right, using the first Some value
+// as the starting accumulator. It returns None if opts has no Some values.
+//
+// Example:
+//
+//	latest := Reduce(times, func(a, b time.Time) time.Time {
+//		if b.After(a) { return b }
+//		return a
+//	})
+func Reduce[T any](opts []Option[T], fn func(T, T) T) Option[T] {
+	var acc Option[T]
+	for _, o := range opts {
+		if !o.ok {
+			continue
+		}
+		if acc.ok {
+			acc.value = fn(acc.value, o.value)
+		} else {
+			acc = o
+		}
+	}
+	if !acc.ok {
+		return noneFrom(opts)
+	}
+	return acc
+}
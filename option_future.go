@@ -0,0 +1,169 @@
+package gopt
+
+import (
+	"context"
+	"sync"
+)
+
+// Future is an Option[T] that becomes available later. It is completed exactly once, through
+// its Promise or by the function passed to Go; completing it with None means "no value".
+// A Future may be awaited by any number of goroutines.
+//
+// Example:
+//
+//	f := Go(func() Option[Profile] { return fetchProfile(id) })
+//	ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+//	defer cancel()
+//	profile := f.Await(ctx)  // None on timeout or if there is no profile
+type Future[T any] struct {
+	done chan struct{}
+	val  Option[T] // written once, before done is closed
+}
+
+// Promise is the write side of a Future.
+type Promise[T any] struct {
+	f    *Future[T]
+	once sync.Once
+}
+
+// NewPromise returns a Promise whose Future is completed by Complete or Resolve.
+//
+// Example:
+//
+//	p := NewPromise[string]()
+//	go func() { p.Complete(lookup(key)) }()
+//	v := p.Future().Await(ctx)
+func NewPromise[T any]() *Promise[T] {
+	return &Promise[T]{f: &Future[T]{done: make(chan struct{})}}
+}
+
+// Future returns the Future completed by p.
+//
+// Example:
+//
+//	f := p.Future()
+func (p *Promise[T]) Future() *Future[T] {
+	return p.f
+}
+
+// Complete completes the Future with o and reports whether this call completed it.
+// Only the first call has an effect; later calls return false.
+//
+// Example:
+//
+//	p.Complete(None[string]())  // no value
+func (p *Promise[T]) Complete(o Option[T]) bool {
+	completed := false
+	p.once.Do(func() {
+		p.f.val = o
+		close(p.f.done)
+		completed = true
+	})
+	return completed
+}
+
+// Resolve completes the Future with Some(v); see Complete.
+//
+// Example:
+//
+//	p.Resolve("eu-west-1")
+func (p *Promise[T]) Resolve(v T) bool {
+	return p.Complete(Some(v))
+}
+
+// Go runs fn in a new goroutine and returns a Future completed with its result.
+// As with any goroutine, a panic in fn crashes the program.
+//
+// Example:
+//
+//	f := Go(func() Option[int] { return Try(strconv.Atoi(slowRead())) })
+func Go[T any](fn func() Option[T]) *Future[T] {
+	p := NewPromise[T]()
+	go func() { p.Complete(fn()) }()
+	return p.f
+}
+
+// Resolved returns a Future already completed with o.
+//
+// Example:
+//
+//	f := Resolved(Some(cached))
+func Resolved[T any](o Option[T]) *Future[T] {
+	p := NewPromise[T]()
+	p.Complete(o)
+	return p.f
+}
+
+// Await blocks until f is completed and returns its option, or returns None if ctx is done first.
+// A Future that is already completed returns its option even if ctx is done.
+//
+// Example:
+//
+//	v := f.Await(ctx)
+func (f *Future[T]) Await(ctx context.Context) Option[T] {
+	select {
+	case <-f.done:
+		return f.val
+	default:
+	}
+	select {
+	case <-f.done:
+		return f.val
+	case <-ctx.Done():
+		return None[T]()
+	}
+}
+
+// Poll returns f's option without blocking; it is None while f is not yet completed.
+// Use Done to tell "not yet" apart from "completed with None".
+//
+// Example:
+//
+//	if v, ok := f.Poll().Get(); ok { use(v) }
+func (f *Future[T]) Poll() Option[T] {
+	select {
+	case <-f.done:
+		return f.val
+	default:
+		return None[T]()
+	}
+}
+
+// Done returns a channel that is closed when f is completed, for use in select.
+//
+// Example:
+//
+//	select {
+//	case <-f.Done():
+//		v := f.Poll()
+//	case <-tick.C:
+//	}
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+// Then returns a Future completed with fn(value) once f completes with Some, or with None
+// if f completes with None (AndThen semantics). fn runs on its own goroutine; if f never
+// completes, that goroutine waits forever.
+//
+// Example:
+//
+//	org := Then(userF, func(u User) Option[Org] { return findOrg(u.OrgID) })
+func Then[T, U any](f *Future[T], fn func(T) Option[U]) *Future[U] {
+	return Go(func() Option[U] {
+		<-f.done
+		return AndThen(f.val, fn)
+	})
+}
+
+// ThenMap is like Then for a function that always produces a value (Map semantics).
+//
+// Example:
+//
+//	name := ThenMap(userF, func(u User) string { return u.Name })
+func ThenMap[T, U any](f *Future[T], fn func(T) U) *Future[U] {
+	return Go(func() Option[U] {
+		<-f.done
+		return Map(f.val, fn)
+	})
+}
@@ -0,0 +1,91 @@
+package gopt
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPromise(t *testing.T) {
+	p := NewPromise[int]()
+	f := p.Future()
+	if f.Poll().IsSome() {
+		t.Fatal("Poll() before completion should be None")
+	}
+	select {
+	case <-f.Done():
+		t.Fatal("Done() should not be closed before completion")
+	default:
+	}
+	if !p.Resolve(1) || p.Resolve(2) || p.Complete(None[int]()) {
+		t.Fatal("only the first completion should succeed")
+	}
+	<-f.Done()
+	if v := f.Await(context.Background()); v.UnwrapOr(0) != 1 {
+		t.Fatalf("Await() = %v; want Some(1)", v)
+	}
+	if v := f.Poll(); v.UnwrapOr(0) != 1 {
+		t.Fatalf("Poll() = %v; want Some(1)", v)
+	}
+}
+
+func TestPromiseConcurrentComplete(t *testing.T) {
+	p := NewPromise[int]()
+	var wins atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if p.Resolve(i) {
+				wins.Add(1)
+			}
+			p.Future().Await(context.Background())
+		}()
+	}
+	wg.Wait()
+	if wins.Load() != 1 {
+		t.Fatalf("%d Resolve calls succeeded; want 1", wins.Load())
+	}
+}
+
+func TestAwaitCancel(t *testing.T) {
+	f := NewPromise[int]().Future()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if f.Await(ctx).IsSome() {
+		t.Fatal("Await() on a timed-out context should be None")
+	}
+
+	done, cancelDone := context.WithCancel(context.Background())
+	cancelDone()
+	resolved := Resolved(Some(1))
+	for i := 0; i < 100; i++ {
+		if v := resolved.Await(done); v.UnwrapOr(0) != 1 {
+			t.Fatalf("Await() of a completed Future with a cancelled context = %v; want Some(1)", v)
+		}
+	}
+}
+
+func TestGoThen(t *testing.T) {
+	ctx := context.Background()
+	f := Go(func() Option[string] { return Some("21") })
+	n := Then(f, func(s string) Option[int] { return Try(strconv.Atoi(s)) })
+	d := ThenMap(n, func(x int) int { return x * 2 })
+	if v := d.Await(ctx); v.UnwrapOr(0) != 42 {
+		t.Fatalf("Then/ThenMap = %v; want Some(42)", v)
+	}
+	bad := Then(Resolved(Some("x")), func(s string) Option[int] { return Try(strconv.Atoi(s)) })
+	if bad.Await(ctx).IsSome() {
+		t.Fatal("Then with None from fn should be None")
+	}
+	calls := 0
+	none := ThenMap(Resolved(None[int]()), func(x int) int { calls++; return x })
+	if none.Await(ctx).IsSome() || calls != 0 {
+		t.Fatal("ThenMap on None should be None without calling fn")
+	}
+}
@@ -67,7 +67,7 @@ func (o Option[T]) MarshalJSON() ([]byte, error) {
 func (o *Option[T]) UnmarshalJSON(data []byte) error {
 	trimmed := bytes.TrimSpace(data)
 	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
-		*o = Option[T]{ok: false}
+		*o = None[T]()
 		return nil
 	}
 	o.ok = true
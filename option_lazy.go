@@ -0,0 +1,75 @@
+package gopt
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Lazy is an Option computed on first use. Get is safe for concurrent use: the function runs
+// at most once at a time, and once it has produced a result every caller sees that result.
+// Create with NewLazy or NewLazyRetry; the zero value is not usable.
+//
+// If the function panics, nothing is cached and the panic propagates; the next Get calls it again.
+//
+// Example:
+//
+//	var featureCfg = NewLazy(func() Option[Config] { return loadConfig("features.yaml") })
+//	if cfg, ok := featureCfg.Get().Get(); ok { apply(cfg) }
+type Lazy[T any] struct {
+	fn    func() Option[T]
+	retry bool
+	mu    sync.Mutex
+	val   atomic.Pointer[Option[T]]
+}
+
+// NewLazy returns a Lazy that calls fn on the first Get and caches its result, Some or None.
+//
+// Example:
+//
+//	l := NewLazy(func() Option[string] { return Lookup(env, "REGION") })
+func NewLazy[T any](fn func() Option[T]) *Lazy[T] {
+	return &Lazy[T]{fn: fn}
+}
+
+// NewLazyRetry is like NewLazy but only caches Some: while fn returns None, each Get calls
+// it again. Use it when None means "not available yet" rather than "absent".
+//
+// Example:
+//
+//	leader := NewLazyRetry(func() Option[string] { return discoverLeader() })
+func NewLazyRetry[T any](fn func() Option[T]) *Lazy[T] {
+	return &Lazy[T]{fn: fn, retry: true}
+}
+
+// Get returns the cached result, computing it first if needed. Concurrent callers that
+// arrive while the function is running wait for it and share its result.
+//
+// Example:
+//
+//	cfg := featureCfg.Get()
+func (l *Lazy[T]) Get() Option[T] {
+	if v := l.val.Load(); v != nil {
+		return *v
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if v := l.val.Load(); v != nil {
+		return *v
+	}
+	v := l.fn()
+	if v.ok || !l.retry {
+		l.val.Store(&v)
+	}
+	return v
+}
+
+// Reset discards the cached result so the next Get computes it again. It is intended for tests.
+//
+// Example:
+//
+//	t.Cleanup(featureCfg.Reset)
+func (l *Lazy[T]) Reset() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.val.Store(nil)
+}
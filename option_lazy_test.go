@@ -0,0 +1,77 @@
+package gopt
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestLazy(t *testing.T) {
+	var calls atomic.Int32
+	l := NewLazy(func() Option[int] { calls.Add(1); return Some(42) })
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if v := l.Get(); v.UnwrapOr(0) != 42 {
+				t.Errorf("Get() = %v; want Some(42)", v)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := calls.Load(); n != 1 {
+		t.Fatalf("fn called %d times; want 1", n)
+	}
+	l.Reset()
+	l.Get()
+	if n := calls.Load(); n != 2 {
+		t.Fatalf("fn called %d times after Reset; want 2", n)
+	}
+}
+
+func TestLazyCachesNone(t *testing.T) {
+	calls := 0
+	l := NewLazy(func() Option[int] { calls++; return None[int]() })
+	l.Get()
+	if l.Get().IsSome() || calls != 1 {
+		t.Fatalf("NewLazy: Get() called fn %d times; want 1 with None cached", calls)
+	}
+}
+
+func TestLazyRetry(t *testing.T) {
+	calls := 0
+	l := NewLazyRetry(func() Option[int] {
+		calls++
+		return Cond(calls >= 3, calls)
+	})
+	for i := 0; i < 2; i++ {
+		if l.Get().IsSome() {
+			t.Fatalf("Get() #%d = Some; want None", i+1)
+		}
+	}
+	if v := l.Get(); v.UnwrapOr(0) != 3 {
+		t.Fatalf("third Get() = %v; want Some(3)", v)
+	}
+	if v := l.Get(); v.UnwrapOr(0) != 3 || calls != 3 {
+		t.Fatalf("Get() after Some = %v with %d calls; want Some(3) cached", v, calls)
+	}
+}
+
+func TestLazyPanicNotCached(t *testing.T) {
+	fail := true
+	l := NewLazy(func() Option[int] {
+		if fail {
+			panic("boom")
+		}
+		return Some(1)
+	})
+	func() {
+		defer func() { recover() }()
+		l.Get()
+	}()
+	fail = false
+	if v := l.Get(); v.UnwrapOr(0) != 1 {
+		t.Fatalf("Get() after panic = %v; want Some(1)", v)
+	}
+}
@@ -0,0 +1,160 @@
+package gopt
+
+// Lookup returns Some(m[k]) if k is present in m, otherwise None.
+// A nil map behaves like an empty map.
+//
+// Example:
+//
+//	m := map[string]int{"a": 1}
+//	Lookup(m, "a")  // Some(1)
+//	Lookup(m, "b")  // None[int]()
+func Lookup[K comparable, V any](m map[K]V, k K) Option[V] {
+	v, ok := m[k]
+	return FromTuple(v, ok)
+}
+
+// LookupPath walks nested map[string]any values (e.g. decoded JSON) following path
+// and returns Some(v) if every key is present, every intermediate value is a
+// map[string]any, and the final value has type T. Otherwise it returns None.
+// An empty path returns m itself when T is map[string]any.
+//
+// Example:
+//
+//	doc := map[string]any{"user": map[string]any{"name": "ann"}}
+//	LookupPath[string](doc, "user", "name")  // Some("ann")
+//	LookupPath[int](doc, "user", "name")     // None[int]() (wrong type)
+func LookupPath[T any](m map[string]any, path ...string) Option[T] {
+	var cur any = m
+	for _, key := range path {
+		obj, ok := cur.(map[string]any)
+		if !ok {
+			return None[T]()
+		}
+		if cur, ok = obj[key]; !ok {
+			return None[T]()
+		}
+	}
+	v, ok := cur.(T)
+	return FromTuple(v, ok)
+}
+
+// MapEntry is a view into a single key of a map, created by Entry.
+// It mirrors Rust's HashMap entry API: inspect, insert, modify or remove
+// the value for one key without repeating comma-ok lookups.
+// Methods that insert panic if the underlying map is nil.
+//
+// Example:
+//
+//	counts := map[string]int{}
+//	Entry(counts, "go").AndModify(func(n int) int { return n + 1 }).OrInsert(1)
+type MapEntry[K comparable, V any] struct {
+	m   map[K]V
+	key K
+}
+
+// Entry returns the entry for key k in m.
+//
+// Example:
+//
+//	e := Entry(m, "key")
+func Entry[K comparable, V any](m map[K]V, k K) MapEntry[K, V] {
+	return MapEntry[K, V]{m: m, key: k}
+}
+
+// Key returns the key of the entry.
+//
+// Example:
+//
+//	Entry(m, "a").Key()  // "a"
+func (e MapEntry[K, V]) Key() K {
+	return e.key
+}
+
+// Get returns Some(value) if the key is present, otherwise None.
+//
+// Example:
+//
+//	Entry(map[string]int{"a": 1}, "a").Get()  // Some(1)
+func (e MapEntry[K, V]) Get() Option[V] {
+	return Lookup(e.m, e.key)
+}
+
+// OrInsert inserts v if the key is absent and returns the value now stored for the key.
+//
+// Example:
+//
+//	m := map[string]int{}
+//	Entry(m, "a").OrInsert(1)  // 1; m["a"] == 1
+//	Entry(m, "a").OrInsert(2)  // 1; m["a"] unchanged
+func (e MapEntry[K, V]) OrInsert(v V) V {
+	if cur, ok := e.m[e.key]; ok {
+		return cur
+	}
+	e.m[e.key] = v
+	return v
+}
+
+// OrInsertWith inserts fn() if the key is absent and returns the value now stored for the key.
+// fn is only called when the key is absent.
+//
+// Example:
+//
+//	Entry(m, "list").OrInsertWith(func() []int { return make([]int, 0, 8) })
+func (e MapEntry[K, V]) OrInsertWith(fn func() V) V {
+	if cur, ok := e.m[e.key]; ok {
+		return cur
+	}
+	v := fn()
+	e.m[e.key] = v
+	return v
+}
+
+// AndModify replaces the stored value with fn(value) if the key is present,
+// then returns the entry for further chaining. Absent keys are left untouched.
+//
+// Example:
+//
+//	Entry(m, "a").AndModify(func(n int) int { return n + 1 }).OrInsert(1)
+func (e MapEntry[K, V]) AndModify(fn func(V) V) MapEntry[K, V] {
+	if cur, ok := e.m[e.key]; ok {
+		e.m[e.key] = fn(cur)
+	}
+	return e
+}
+
+// Remove deletes the key from the map and returns Some(previous value), or None if it was absent.
+//
+// Example:
+//
+//	m := map[string]int{"a": 1}
+//	Entry(m, "a").Remove()  // Some(1); "a" no longer in m
+func (e MapEntry[K, V]) Remove() Option[V] {
+	cur, ok := e.m[e.key]
+	if !ok {
+		return None[V]()
+	}
+	delete(e.m, e.key)
+	return Some(cur)
+}
+
+// Update calls fn with the current value for k (Some if present, None if absent)
+// and stores the result: Some(v) sets m[k] = v, None deletes k.
+// It returns the option produced by fn.
+//
+// Example:
+//
+//	// increment, starting at 1
+//	Update(m, "a", func(o Option[int]) Option[int] { return Some(o.UnwrapOr(0) + 1) })
+//	// delete when the count drops to zero
+//	Update(m, "a", func(o Option[int]) Option[int] {
+//		return Map(o, func(n int) int { return n - 1 }).Filter(func(n int) bool { return n > 0 })
+//	})
+func Update[K comparable, V any](m map[K]V, k K, fn func(Option[V]) Option[V]) Option[V] {
+	next := fn(Lookup(m, k))
+	if v, ok := next.Get(); ok {
+		m[k] = v
+	} else {
+		delete(m, k)
+	}
+	return next
+}
@@ -0,0 +1,108 @@
+package gopt
+
+import "testing"
+
+func TestLookup(t *testing.T) {
+	m := map[string]int{"a": 1}
+	if v := Lookup(m, "a"); !v.IsSome() || v.Unwrap() != 1 {
+		t.Fatalf("Lookup(m, \"a\") = %v; want Some(1)", v)
+	}
+	if Lookup(m, "b").IsSome() {
+		t.Fatal("Lookup(m, \"b\") should be None")
+	}
+	if Lookup[string, int](nil, "a").IsSome() {
+		t.Fatal("Lookup(nil, \"a\") should be None")
+	}
+}
+
+func TestLookupPath(t *testing.T) {
+	doc := map[string]any{
+		"user": map[string]any{
+			"name": "ann",
+			"age":  30.0,
+		},
+		"tags": []any{"x"},
+	}
+	if v := LookupPath[string](doc, "user", "name"); !v.IsSome() || v.Unwrap() != "ann" {
+		t.Fatalf("LookupPath(user.name) = %v; want Some(\"ann\")", v)
+	}
+	if v := LookupPath[float64](doc, "user", "age"); !v.IsSome() || v.Unwrap() != 30 {
+		t.Fatalf("LookupPath(user.age) = %v; want Some(30)", v)
+	}
+	if LookupPath[int](doc, "user", "name").IsSome() {
+		t.Fatal("LookupPath with wrong type should be None")
+	}
+	if LookupPath[string](doc, "user", "missing").IsSome() {
+		t.Fatal("LookupPath with missing key should be None")
+	}
+	if LookupPath[string](doc, "tags", "x").IsSome() {
+		t.Fatal("LookupPath through non-map should be None")
+	}
+	if !LookupPath[map[string]any](doc).IsSome() {
+		t.Fatal("LookupPath with empty path should return the root map")
+	}
+}
+
+func TestEntry(t *testing.T) {
+	m := map[string]int{}
+	if v := Entry(m, "a").OrInsert(1); v != 1 || m["a"] != 1 {
+		t.Fatalf("OrInsert on absent key = %v, m[a]=%v; want 1, 1", v, m["a"])
+	}
+	if v := Entry(m, "a").OrInsert(2); v != 1 || m["a"] != 1 {
+		t.Fatalf("OrInsert on present key = %v, m[a]=%v; want 1, 1", v, m["a"])
+	}
+
+	calls := 0
+	gen := func() int { calls++; return 5 }
+	Entry(m, "a").OrInsertWith(gen)
+	if calls != 0 {
+		t.Fatal("OrInsertWith should not call fn when key is present")
+	}
+	if v := Entry(m, "b").OrInsertWith(gen); v != 5 || calls != 1 {
+		t.Fatalf("OrInsertWith on absent key = %v (calls=%d); want 5 (calls=1)", v, calls)
+	}
+
+	inc := func(n int) int { return n + 1 }
+	if v := Entry(m, "a").AndModify(inc).OrInsert(100); v != 2 {
+		t.Fatalf("AndModify(inc).OrInsert on present key = %v; want 2", v)
+	}
+	if v := Entry(m, "c").AndModify(inc).OrInsert(100); v != 100 {
+		t.Fatalf("AndModify(inc).OrInsert on absent key = %v; want 100", v)
+	}
+
+	e := Entry(m, "c")
+	if e.Key() != "c" || e.Get().Unwrap() != 100 {
+		t.Fatalf("Entry(c) Key/Get = %v, %v; want c, Some(100)", e.Key(), e.Get())
+	}
+	if v := e.Remove(); !v.IsSome() || v.Unwrap() != 100 {
+		t.Fatalf("Remove() = %v; want Some(100)", v)
+	}
+	if _, ok := m["c"]; ok {
+		t.Fatal("Remove should delete the key")
+	}
+	if e.Remove().IsSome() {
+		t.Fatal("Remove on absent key should be None")
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	m := map[string]int{}
+	incr := func(o Option[int]) Option[int] { return Some(o.UnwrapOr(0) + 1) }
+	Update(m, "a", incr)
+	if r := Update(m, "a", incr); r.Unwrap() != 2 || m["a"] != 2 {
+		t.Fatalf("Update twice = %v, m[a]=%v; want Some(2), 2", r, m["a"])
+	}
+	decr := func(o Option[int]) Option[int] {
+		return Map(o, func(n int) int { return n - 1 }).Filter(func(n int) bool { return n > 0 })
+	}
+	Update(m, "a", decr)
+	if m["a"] != 1 {
+		t.Fatalf("m[a] = %v; want 1", m["a"])
+	}
+	if r := Update(m, "a", decr); r.IsSome() {
+		t.Fatalf("Update returning None = %v; want None", r)
+	}
+	if _, ok := m["a"]; ok {
+		t.Fatal("Update returning None should delete the key")
+	}
+}
@@ -0,0 +1,15 @@
+//go:build !gopt_debug
+
+package gopt
+
+// noneSource is where a None was created. Without the gopt_debug tag it is empty, so
+// Option keeps its size and recording costs nothing.
+type noneSource struct{}
+
+func newNoneSource() noneSource {
+	return noneSource{}
+}
+
+func (noneSource) location() Option[Location] {
+	return Option[Location]{}
+}
@@ -0,0 +1,26 @@
+//go:build !gopt_debug
+
+package gopt
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func TestOptionSize(t *testing.T) {
+	if got := unsafe.Sizeof(Option[int]{}); got != 16 {
+		t.Fatalf("Sizeof(Option[int]) = %d; want 16", got)
+	}
+	if got := unsafe.Sizeof(Option[bool]{}); got != 2 {
+		t.Fatalf("Sizeof(Option[bool]) = %d; want 2", got)
+	}
+}
+
+func TestOriginNotRecorded(t *testing.T) {
+	if Origin(None[int]()).IsSome() {
+		t.Fatal("Origin should be None without the gopt_debug tag")
+	}
+	if (&UnwrapError{}).Origin.IsSome() {
+		t.Fatal("UnwrapError.Origin should be None without the gopt_debug tag")
+	}
+}
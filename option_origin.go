@@ -0,0 +1,71 @@
+package gopt
+
+import "fmt"
+
+// Location is a source position recorded for a None in debug builds.
+type Location struct {
+	File string // file of the call that produced the None
+	Line int    // line of that call
+	Func string // fully qualified name of the calling function
+}
+
+// String returns the location as "file:line".
+func (l Location) String() string {
+	return fmt.Sprintf("%s:%d", l.File, l.Line)
+}
+
+// Origin returns where o became None: the first call site outside this package that created it
+// with None, Try, FromPtr, Filter, Div and so on. Combinators such as Map, AndThen, Zip and Sum
+// pass the origin of their None input through unchanged, so the origin points at the step that
+// actually failed rather than the end of the chain.
+//
+// Origins are only recorded when the program is built with the gopt_debug tag
+// (go test -tags gopt_debug ./...). In normal builds, and for Some or the zero value,
+// Origin returns None. In debug builds two Nones from different places are not == equal;
+// use Equals or Equal to compare options.
+//
+// Example:
+//
+//	o := AndThen(Try(strconv.Atoi(s)), lookup)
+//	if loc, ok := Origin(o).Get(); ok { log.Printf("None from %s (%s)", loc, loc.Func) }
+func Origin[T any](o Option[T]) Option[Location] {
+	if o.ok {
+		return Option[Location]{}
+	}
+	return o.src.location()
+}
+
+// propagate returns None[U] carrying the origin of the None o.
+func propagate[U, T any](o Option[T]) Option[U] {
+	return Option[U]{src: o.src}
+}
+
+// source returns the origin of o if it is None, and the zero source if it is Some.
+func (o Option[T]) source() noneSource {
+	if o.ok {
+		return noneSource{}
+	}
+	return o.src
+}
+
+// firstSource returns the first recorded origin among srcs, so that combinators with several
+// inputs report the earliest failing argument.
+func firstSource(srcs ...noneSource) noneSource {
+	for _, s := range srcs {
+		if s != (noneSource{}) {
+			return s
+		}
+	}
+	return noneSource{}
+}
+
+// noneFrom returns None carrying the first recorded origin among the Nones in opts, or a None
+// recorded at the caller when there is none to pass on (empty input, or zero-value Nones).
+func noneFrom[T any](opts []Option[T]) Option[T] {
+	for _, o := range opts {
+		if s := o.source(); s != (noneSource{}) {
+			return Option[T]{src: s}
+		}
+	}
+	return None[T]()
+}
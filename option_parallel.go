@@ -0,0 +1,140 @@
+package gopt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+)
+
+// ParallelMap is the concurrent counterpart of AndThen over a slice: out[i] is fn(ctx, v)
+// for each Some(v) in opts, computed by at most workers goroutines (workers <= 0 means
+// GOMAXPROCS). Order is preserved and None inputs pass through without calling fn.
+// Items not yet started when ctx is done are left None. If fn panics, the remaining items
+// are cancelled and ParallelMap panics with a *PanicError once all workers have stopped.
+//
+// Example:
+//
+//	users := ParallelMap(ctx, ids, 16, func(ctx context.Context, id int) Option[User] {
+//		return findUser(ctx, id)
+//	})
+func ParallelMap[T, U any](ctx context.Context, opts []Option[T], workers int, fn func(context.Context, T) Option[U]) []Option[U] {
+	out := make([]Option[U], len(opts))
+	parallelEach(ctx, len(opts), workers, func(ctx context.Context, i int) {
+		if !opts[i].ok {
+			out[i] = propagate[U](opts[i])
+			return
+		}
+		out[i] = fn(ctx, opts[i].value)
+	})
+	return out
+}
+
+// ParallelFilterMap is like ParallelMap but returns only the Some results, in input order.
+//
+// Example:
+//
+//	found := ParallelFilterMap(ctx, ids, 16, findUser)  // []User
+func ParallelFilterMap[T, U any](ctx context.Context, opts []Option[T], workers int, fn func(context.Context, T) Option[U]) []U {
+	var out []U
+	for _, o := range ParallelMap(ctx, opts, workers, fn) {
+		if o.ok {
+			out = append(out, o.value)
+		}
+	}
+	return out
+}
+
+// ItemError is the error of one item in ParallelTryMap.
+type ItemError struct {
+	Index int   // index of the item in the input slice
+	Err   error // error returned by fn
+}
+
+func (e *ItemError) Error() string {
+	return fmt.Sprintf("gopt: item %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the error returned by fn.
+func (e *ItemError) Unwrap() error {
+	return e.Err
+}
+
+// ParallelTryMap is like ParallelMap for a fn that can fail. A failed item is None in the
+// result and its error is collected as an *ItemError; the returned error joins them in input
+// order, followed by ctx.Err() if the context was done before all items were processed.
+// It is nil if every item succeeded. Failures do not stop the other items.
+//
+// Example:
+//
+//	recs, err := ParallelTryMap(ctx, ids, 8, fetchRecord)
+//	var ie *ItemError
+//	if errors.As(err, &ie) { log.Printf("item %d failed: %v", ie.Index, ie.Err) }
+func ParallelTryMap[T, U any](ctx context.Context, opts []Option[T], workers int, fn func(context.Context, T) (U, error)) ([]Option[U], error) {
+	out := make([]Option[U], len(opts))
+	errs := make([]error, len(opts))
+	complete := parallelEach(ctx, len(opts), workers, func(ctx context.Context, i int) {
+		if !opts[i].ok {
+			out[i] = propagate[U](opts[i])
+			return
+		}
+		v, err := fn(ctx, opts[i].value)
+		if err != nil {
+			errs[i] = &ItemError{Index: i, Err: err}
+			return
+		}
+		out[i] = Some(v)
+	})
+	if !complete {
+		errs = append(errs, ctx.Err())
+	}
+	return out, errors.Join(errs...)
+}
+
+// parallelEach calls fn(ctx, i) for every i in [0, n) using at most workers goroutines.
+// Once ctx is done, remaining indices are skipped and parallelEach reports false.
+// The first panic cancels the rest and is re-raised as a *PanicError after all workers stop.
+func parallelEach(ctx context.Context, n, workers int, fn func(context.Context, int)) bool {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = min(workers, n)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var next atomic.Int64
+	var skipped atomic.Bool
+	var panicked atomic.Pointer[PanicError]
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if p := recover(); p != nil {
+					panicked.CompareAndSwap(nil, &PanicError{Value: p, Stack: debug.Stack()})
+					cancel()
+				}
+			}()
+			for {
+				i := int(next.Add(1) - 1)
+				if i >= n {
+					return
+				}
+				if ctx.Err() != nil {
+					skipped.Store(true)
+					return
+				}
+				fn(ctx, i)
+			}
+		}()
+	}
+	wg.Wait()
+	if p := panicked.Load(); p != nil {
+		panic(p)
+	}
+	return !skipped.Load()
+}
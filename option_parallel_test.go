@@ -0,0 +1,100 @@
+package gopt
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParallelMap(t *testing.T) {
+	opts := []Option[int]{Some(1), None[int](), Some(3), Some(4), None[int](), Some(6)}
+	var calls atomic.Int32
+	out := ParallelMap(context.Background(), opts, 3, func(_ context.Context, x int) Option[string] {
+		calls.Add(1)
+		time.Sleep(time.Duration(7-x) * time.Millisecond) // finish out of order
+		return Cond(x != 4, strconv.Itoa(x))
+	})
+	want := []Option[string]{Some("1"), None[string](), Some("3"), None[string](), None[string](), Some("6")}
+	if !slices.EqualFunc(out, want, Equals[string]) {
+		t.Fatalf("ParallelMap = %v; want %v", out, want)
+	}
+	if calls.Load() != 4 {
+		t.Fatalf("fn called %d times; want 4 (None inputs skipped)", calls.Load())
+	}
+	if got := ParallelMap(context.Background(), nil, 0, func(context.Context, int) Option[int] { return Some(1) }); len(got) != 0 {
+		t.Fatalf("ParallelMap(nil) = %v; want empty", got)
+	}
+}
+
+func TestParallelFilterMap(t *testing.T) {
+	opts := []Option[int]{Some(1), None[int](), Some(2), Some(3)}
+	out := ParallelFilterMap(context.Background(), opts, 0, func(_ context.Context, x int) Option[int] {
+		return Cond(x%2 == 1, x*10)
+	})
+	if !slices.Equal(out, []int{10, 30}) {
+		t.Fatalf("ParallelFilterMap = %v; want [10 30]", out)
+	}
+}
+
+func TestParallelMapCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	opts := []Option[int]{Some(1), Some(2), Some(3), Some(4)}
+	out, err := ParallelTryMap(ctx, opts, 1, func(_ context.Context, x int) (int, error) {
+		if x == 2 {
+			cancel()
+		}
+		return x, nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v; want context.Canceled", err)
+	}
+	if out[0].IsNone() || out[1].IsNone() || out[2].IsSome() || out[3].IsSome() {
+		t.Fatalf("out = %v; want [Some(1) Some(2) None None]", out)
+	}
+}
+
+func TestParallelTryMap(t *testing.T) {
+	opts := []Option[string]{Some("1"), Some("x"), None[string](), Some("3"), Some("y")}
+	out, err := ParallelTryMap(context.Background(), opts, 2, func(_ context.Context, s string) (int, error) {
+		return strconv.Atoi(s)
+	})
+	want := []Option[int]{Some(1), None[int](), None[int](), Some(3), None[int]()}
+	if !slices.EqualFunc(out, want, Equals[int]) {
+		t.Fatalf("ParallelTryMap = %v; want %v", out, want)
+	}
+	var ie *ItemError
+	if !errors.As(err, &ie) || ie.Index != 1 {
+		t.Fatalf("err = %v; want *ItemError for item 1 first", err)
+	}
+	var numErr *strconv.NumError
+	if !errors.As(err, &numErr) {
+		t.Fatalf("err = %v; want it to wrap *strconv.NumError", err)
+	}
+	if n := len(err.(interface{ Unwrap() []error }).Unwrap()); n != 2 {
+		t.Fatalf("err joins %d errors; want 2", n)
+	}
+	if _, err := ParallelTryMap(context.Background(), opts[:1], 2, func(_ context.Context, s string) (int, error) {
+		return strconv.Atoi(s)
+	}); err != nil {
+		t.Fatalf("err = %v; want nil", err)
+	}
+}
+
+func TestParallelMapPanic(t *testing.T) {
+	defer func() {
+		if pe, ok := recover().(*PanicError); !ok || pe.Value != "boom" {
+			t.Fatalf("recovered %v; want *PanicError with boom", pe)
+		}
+	}()
+	ParallelMap(context.Background(), []Option[int]{Some(1), Some(2)}, 2, func(_ context.Context, x int) Option[int] {
+		if x == 2 {
+			panic("boom")
+		}
+		return Some(x)
+	})
+	t.Fatal("ParallelMap should re-raise the panic")
+}
@@ -0,0 +1,150 @@
+package gopt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// ErrGaveUp is returned by PollUntilSomeErr when polling stops without a value: the attempts
+// or time allowed by the PollPolicy ran out, or the context was done.
+var ErrGaveUp = errors.New("gopt: gave up waiting for Some")
+
+// Clock is the time source used by PollUntilSome. Tests can supply a fake that advances
+// instantly instead of sleeping.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+// SystemClock is the Clock backed by the time package.
+var SystemClock Clock = systemClock{}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time                         { return time.Now() }
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// Backoff returns the delay to wait after the given attempt (1 for the first) before the next one.
+type Backoff func(attempt int) time.Duration
+
+// ConstantBackoff waits d between attempts.
+//
+// Example:
+//
+//	policy := PollPolicy{Backoff: ConstantBackoff(time.Second)}
+func ConstantBackoff(d time.Duration) Backoff {
+	return func(int) time.Duration { return d }
+}
+
+// ExponentialBackoff waits initial after the first attempt and doubles the delay after
+// each further attempt, up to maxDelay.
+//
+// Example:
+//
+//	ExponentialBackoff(100*time.Millisecond, 5*time.Second)  // 100ms, 200ms, 400ms, ... 5s
+func ExponentialBackoff(initial, maxDelay time.Duration) Backoff {
+	return func(attempt int) time.Duration {
+		d := initial
+		for i := 1; i < attempt && d < maxDelay; i++ {
+			d *= 2
+		}
+		return min(d, maxDelay)
+	}
+}
+
+// JitteredBackoff randomises the delays of b: each delay is reduced by a random amount up to
+// fraction of it (0 < fraction <= 1), so that many pollers started together spread out.
+//
+// Example:
+//
+//	JitteredBackoff(ExponentialBackoff(time.Second, time.Minute), 0.5)  // 0.5s-1s, 1s-2s, ...
+func JitteredBackoff(b Backoff, fraction float64) Backoff {
+	return func(attempt int) time.Duration {
+		d := b(attempt)
+		return d - time.Duration(rand.Float64()*fraction*float64(d))
+	}
+}
+
+// PollAttempt describes one finished attempt, for PollPolicy.OnAttempt.
+type PollAttempt struct {
+	Attempt int           // 1-based number of the attempt
+	Elapsed time.Duration // time since polling started
+	Some    bool          // whether the attempt produced a value
+	Err     error         // error returned by fn; PollUntilSomeErr only
+}
+
+// PollPolicy controls how often and for how long PollUntilSome tries.
+// The zero value polls every second until the context is done.
+type PollPolicy struct {
+	Backoff     Backoff           // delay between attempts; nil means ConstantBackoff(time.Second)
+	MaxAttempts int               // give up after this many attempts; 0 means no limit
+	Timeout     time.Duration     // give up rather than start an attempt this long after the first; 0 means no limit
+	Clock       Clock             // time source; nil means SystemClock
+	OnAttempt   func(PollAttempt) // called after every attempt, e.g. for logging; may be nil
+}
+
+// PollUntilSome calls fn until it returns Some and returns that value. The first call is
+// immediate; later calls follow policy.Backoff. It returns None when policy's attempts or
+// time run out or ctx is done.
+//
+// Example:
+//
+//	out := PollUntilSome(ctx, func(ctx context.Context) Option[Output] { return job.Output(ctx) },
+//		PollPolicy{Backoff: ExponentialBackoff(time.Second, 30*time.Second), Timeout: 10 * time.Minute})
+func PollUntilSome[T any](ctx context.Context, fn func(context.Context) Option[T], policy PollPolicy) Option[T] {
+	v, err := PollUntilSomeErr(ctx, func(ctx context.Context) (Option[T], error) {
+		return fn(ctx), nil
+	}, policy)
+	return FromTuple(v, err == nil)
+}
+
+// PollUntilSomeErr is like PollUntilSome for a fn that can fail. It stops and returns fn's
+// error as soon as fn returns one, and returns an error wrapping ErrGaveUp (and ctx.Err(),
+// if the context was done) when it runs out of attempts or time.
+//
+// Example:
+//
+//	out, err := PollUntilSomeErr(ctx, job.TryOutput, policy)
+//	if errors.Is(err, ErrGaveUp) { /* still running */ }
+func PollUntilSomeErr[T any](ctx context.Context, fn func(context.Context) (Option[T], error), policy PollPolicy) (T, error) {
+	clock := policy.Clock
+	if clock == nil {
+		clock = SystemClock
+	}
+	backoff := policy.Backoff
+	if backoff == nil {
+		backoff = ConstantBackoff(time.Second)
+	}
+	var zero T
+	start := clock.Now()
+	for attempt := 1; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return zero, fmt.Errorf("%w: %w", ErrGaveUp, err)
+		}
+		o, err := fn(ctx)
+		if policy.OnAttempt != nil {
+			policy.OnAttempt(PollAttempt{Attempt: attempt, Elapsed: clock.Now().Sub(start), Some: o.ok, Err: err})
+		}
+		if err != nil {
+			return zero, err
+		}
+		if o.ok {
+			return o.value, nil
+		}
+		if policy.MaxAttempts > 0 && attempt >= policy.MaxAttempts {
+			return zero, fmt.Errorf("%w after %d attempts", ErrGaveUp, attempt)
+		}
+		delay := backoff(attempt)
+		if policy.Timeout > 0 && clock.Now().Add(delay).Sub(start) > policy.Timeout {
+			return zero, fmt.Errorf("%w after %v", ErrGaveUp, policy.Timeout)
+		}
+		select {
+		case <-clock.After(delay):
+		case <-ctx.Done():
+			return zero, fmt.Errorf("%w: %w", ErrGaveUp, ctx.Err())
+		}
+	}
+}
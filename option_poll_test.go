@@ -0,0 +1,126 @@
+package gopt
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock advances instantly: After moves the clock forward by d and fires at once.
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	c.sleeps = append(c.sleeps, d)
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return ch
+}
+
+// someAfter returns a poll function that yields Some(n) on its n-th call.
+func someAfter(n int) func(context.Context) Option[int] {
+	calls := 0
+	return func(context.Context) Option[int] {
+		calls++
+		return Cond(calls >= n, calls)
+	}
+}
+
+func TestPollUntilSome(t *testing.T) {
+	clock := &fakeClock{}
+	var attempts []PollAttempt
+	v := PollUntilSome(context.Background(), someAfter(4), PollPolicy{
+		Backoff:   ExponentialBackoff(time.Second, 3*time.Second),
+		Clock:     clock,
+		OnAttempt: func(a PollAttempt) { attempts = append(attempts, a) },
+	})
+	if v.UnwrapOr(0) != 4 {
+		t.Fatalf("PollUntilSome = %v; want Some(4)", v)
+	}
+	if want := []time.Duration{time.Second, 2 * time.Second, 3 * time.Second}; !slices.Equal(clock.sleeps, want) {
+		t.Fatalf("sleeps = %v; want %v", clock.sleeps, want)
+	}
+	if len(attempts) != 4 || attempts[3].Attempt != 4 || !attempts[3].Some || attempts[3].Elapsed != 6*time.Second {
+		t.Fatalf("attempts = %+v; want 4, the last Some after 6s", attempts)
+	}
+}
+
+func TestPollUntilSomeGivesUp(t *testing.T) {
+	clock := &fakeClock{}
+	if PollUntilSome(context.Background(), someAfter(10), PollPolicy{Backoff: ConstantBackoff(time.Second), MaxAttempts: 3, Clock: clock}).IsSome() {
+		t.Fatal("PollUntilSome should give up after MaxAttempts")
+	}
+	if len(clock.sleeps) != 2 {
+		t.Fatalf("slept %d times; want 2", len(clock.sleeps))
+	}
+
+	clock = &fakeClock{}
+	_, err := PollUntilSomeErr(context.Background(), func(context.Context) (Option[int], error) {
+		return None[int](), nil
+	}, PollPolicy{Backoff: ConstantBackoff(time.Second), Timeout: 5 * time.Second, Clock: clock})
+	if !errors.Is(err, ErrGaveUp) || len(clock.sleeps) != 5 {
+		t.Fatalf("Timeout: err = %v after %d sleeps; want ErrGaveUp after 5", err, len(clock.sleeps))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = PollUntilSomeErr(ctx, func(context.Context) (Option[int], error) {
+		return Some(1), nil
+	}, PollPolicy{Clock: &fakeClock{}})
+	if !errors.Is(err, ErrGaveUp) || !errors.Is(err, context.Canceled) {
+		t.Fatalf("cancelled: err = %v; want ErrGaveUp and context.Canceled", err)
+	}
+}
+
+func TestPollUntilSomeErr(t *testing.T) {
+	calls := 0
+	_, err := PollUntilSomeErr(context.Background(), func(context.Context) (Option[int], error) {
+		calls++
+		if calls == 2 {
+			return None[int](), errTest
+		}
+		return None[int](), nil
+	}, PollPolicy{Clock: &fakeClock{}})
+	if err != errTest || calls != 2 {
+		t.Fatalf("err = %v after %d calls; want errTest after 2", err, calls)
+	}
+	v, err := PollUntilSomeErr(context.Background(), func(context.Context) (Option[string], error) {
+		return Some("done"), nil
+	}, PollPolicy{})
+	if v != "done" || err != nil {
+		t.Fatalf("PollUntilSomeErr = %q, %v; want done, nil", v, err)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	if d := ConstantBackoff(time.Second)(7); d != time.Second {
+		t.Fatalf("ConstantBackoff = %v; want 1s", d)
+	}
+	exp := ExponentialBackoff(100*time.Millisecond, time.Second)
+	for attempt, want := range map[int]time.Duration{1: 100 * time.Millisecond, 2: 200 * time.Millisecond, 4: 800 * time.Millisecond, 5: time.Second, 60: time.Second} {
+		if d := exp(attempt); d != want {
+			t.Fatalf("ExponentialBackoff(%d) = %v; want %v", attempt, d, want)
+		}
+	}
+	jit := JitteredBackoff(ConstantBackoff(time.Second), 0.5)
+	for i := 0; i < 100; i++ {
+		if d := jit(1); d < 500*time.Millisecond || d > time.Second {
+			t.Fatalf("JitteredBackoff = %v; want within [500ms, 1s]", d)
+		}
+	}
+}
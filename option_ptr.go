@@ -0,0 +1,234 @@
+package gopt
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Ptr is a pointer-sized optional pointer: a nil pointer is None, a non-nil pointer is Some.
+// It is the niche-optimised equivalent of Option[*T]: a single word instead of a pointer
+// plus a bool padded to two words, which matters for large caches and slices.
+// The zero value is None. Create with PtrOf, NonePtr, or PtrFromOption.
+//
+// Example:
+//
+//	p := PtrOf(&user)
+//	if u, ok := p.Get(); ok { fmt.Println(u.Name) }
+type Ptr[T any] struct {
+	p *T
+}
+
+// PtrOf returns a Ptr holding p. A nil p gives None.
+//
+// Example:
+//
+//	x := 7
+//	PtrOf(&x)       // Some(&x)
+//	PtrOf[int](nil)  // None
+func PtrOf[T any](p *T) Ptr[T] {
+	return Ptr[T]{p: p}
+}
+
+// NonePtr returns an empty Ptr.
+//
+// Example:
+//
+//	p := NonePtr[int]()
+func NonePtr[T any]() Ptr[T] {
+	return Ptr[T]{}
+}
+
+// PtrFromOption converts Option[*T] to Ptr[T]. None and Some(nil) both become None,
+// since Ptr cannot represent a present nil pointer.
+//
+// Example:
+//
+//	PtrFromOption(Some(&x))  // Some(&x)
+//	PtrFromOption(None[*int]())  // None
+func PtrFromOption[T any](o Option[*T]) Ptr[T] {
+	return Ptr[T]{p: o.value}
+}
+
+// ToOption converts p to Option[*T]: Some(pointer) if Some, otherwise None.
+//
+// Example:
+//
+//	PtrOf(&x).ToOption()  // Some(&x)
+func (p Ptr[T]) ToOption() Option[*T] {
+	return FromTuple(p.p, p.p != nil)
+}
+
+// Deref returns Some(*p) if Some, otherwise None; the value is copied, as with FromPtr.
+//
+// Example:
+//
+//	x := 7
+//	PtrOf(&x).Deref()  // Some(7)
+func (p Ptr[T]) Deref() Option[T] {
+	return FromPtr(p.p)
+}
+
+// IsSome returns true if the pointer is non-nil.
+//
+// Example:
+//
+//	PtrOf(&x).IsSome()  // true
+func (p Ptr[T]) IsSome() bool {
+	return p.p != nil
+}
+
+// IsNone returns true if the pointer is nil.
+//
+// Example:
+//
+//	NonePtr[int]().IsNone()  // true
+func (p Ptr[T]) IsNone() bool {
+	return p.p == nil
+}
+
+// Get returns the pointer and whether it is non-nil.
+//
+// Example:
+//
+//	v, ok := PtrOf(&x).Get()  // v=&x, ok=true
+func (p Ptr[T]) Get() (*T, bool) {
+	return p.p, p.p != nil
+}
+
+// Unwrap returns the pointer. It panics with an *UnwrapError if p is None.
+//
+// Example:
+//
+//	v := PtrOf(&x).Unwrap()  // &x
+func (p Ptr[T]) Unwrap() *T {
+	if p.p == nil {
+		unwrapPanic[*T](noneSource{}, "", nil)
+	}
+	return p.p
+}
+
+// UnwrapOr returns the pointer if Some, otherwise defaultVal.
+//
+// Example:
+//
+//	NonePtr[int]().UnwrapOr(&fallback)  // &fallback
+func (p Ptr[T]) UnwrapOr(defaultVal *T) *T {
+	if p.p != nil {
+		return p.p
+	}
+	return defaultVal
+}
+
+// UnwrapOrElse returns the pointer if Some, otherwise the result of calling fn.
+//
+// Example:
+//
+//	NonePtr[int]().UnwrapOrElse(func() *int { return new(int) })
+func (p Ptr[T]) UnwrapOrElse(fn func() *T) *T {
+	if p.p != nil {
+		return p.p
+	}
+	return fn()
+}
+
+// Expect returns the pointer if Some. It panics with an *UnwrapError carrying msg if None.
+//
+// Example:
+//
+//	v := PtrOf(&x).Expect("required")
+func (p Ptr[T]) Expect(msg string) *T {
+	if p.p == nil {
+		unwrapPanic[*T](noneSource{}, msg, nil)
+	}
+	return p.p
+}
+
+// Filter returns p if Some and pred(pointer) is true, otherwise returns None.
+//
+// Example:
+//
+//	PtrOf(&x).Filter(func(v *int) bool { return *v > 0 })
+func (p Ptr[T]) Filter(pred func(*T) bool) Ptr[T] {
+	if p.p == nil || !pred(p.p) {
+		return Ptr[T]{}
+	}
+	return p
+}
+
+// Or returns p if Some, otherwise returns other.
+//
+// Example:
+//
+//	NonePtr[int]().Or(PtrOf(&x))  // Some(&x)
+func (p Ptr[T]) Or(other Ptr[T]) Ptr[T] {
+	if p.p != nil {
+		return p
+	}
+	return other
+}
+
+// OrElse returns p if Some, otherwise returns fn().
+//
+// Example:
+//
+//	NonePtr[int]().OrElse(func() Ptr[int] { return PtrOf(&x) })
+func (p Ptr[T]) OrElse(fn func() Ptr[T]) Ptr[T] {
+	if p.p != nil {
+		return p
+	}
+	return fn()
+}
+
+// Tap calls fn with the pointer if Some, then returns p unchanged.
+//
+// Example:
+//
+//	PtrOf(&x).Tap(func(v *int) { *v++ })
+func (p Ptr[T]) Tap(fn func(*T)) Ptr[T] {
+	if p.p != nil {
+		fn(p.p)
+	}
+	return p
+}
+
+// ToPointer returns the pointer, or nil if None. No copy is made.
+//
+// Example:
+//
+//	PtrOf(&x).ToPointer()  // &x
+func (p Ptr[T]) ToPointer() *T {
+	return p.p
+}
+
+// MarshalJSON implements encoding/json.Marshaler. None encodes as null; Some encodes as the pointed-to value.
+//
+// Example:
+//
+//	b, _ := json.Marshal(PtrOf(&x))  // []byte("7")
+func (p Ptr[T]) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON implements encoding/json.Unmarshaler. Null, empty, or
+// whitespace-only input decodes as None; otherwise decodes into a newly allocated T.
+//
+// Example:
+//
+//	var p Ptr[int]
+//	json.Unmarshal([]byte("7"), &p)  // p = Some(&7)
+func (p *Ptr[T]) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		p.p = nil
+		return nil
+	}
+	v := new(T)
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+	p.p = v
+	return nil
+}
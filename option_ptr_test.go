@@ -0,0 +1,106 @@
+package gopt
+
+import (
+	"encoding/json"
+	"testing"
+	"unsafe"
+)
+
+func TestPtrSize(t *testing.T) {
+	if got, want := unsafe.Sizeof(Ptr[int]{}), unsafe.Sizeof(uintptr(0)); got != want {
+		t.Fatalf("Sizeof(Ptr[int]) = %d; want %d", got, want)
+	}
+}
+
+func TestPtr(t *testing.T) {
+	x := 7
+	p := PtrOf(&x)
+	if !p.IsSome() || p.IsNone() {
+		t.Fatal("PtrOf(&x) should be Some")
+	}
+	if v, ok := p.Get(); !ok || v != &x {
+		t.Fatalf("Get() = %v, %v; want &x, true", v, ok)
+	}
+	if p.Unwrap() != &x || p.Expect("x") != &x || p.ToPointer() != &x {
+		t.Fatal("Unwrap/Expect/ToPointer should return the stored pointer")
+	}
+	if !PtrOf[int](nil).IsNone() || !NonePtr[int]().IsNone() || !(Ptr[int]{}).IsNone() {
+		t.Fatal("nil pointer, NonePtr and zero value should be None")
+	}
+
+	y := 8
+	if NonePtr[int]().UnwrapOr(&y) != &y || p.UnwrapOr(&y) != &x {
+		t.Fatal("UnwrapOr mismatch")
+	}
+	if NonePtr[int]().UnwrapOrElse(func() *int { return &y }) != &y {
+		t.Fatal("UnwrapOrElse on None should call fn")
+	}
+	if NonePtr[int]().Or(p).Unwrap() != &x || p.Or(PtrOf(&y)).Unwrap() != &x {
+		t.Fatal("Or mismatch")
+	}
+	if NonePtr[int]().OrElse(func() Ptr[int] { return PtrOf(&y) }).Unwrap() != &y {
+		t.Fatal("OrElse on None should call fn")
+	}
+
+	positive := func(v *int) bool { return *v > 0 }
+	if !p.Filter(positive).IsSome() || NonePtr[int]().Filter(positive).IsSome() {
+		t.Fatal("Filter mismatch")
+	}
+	p.Tap(func(v *int) { *v++ })
+	if x != 8 {
+		t.Fatalf("Tap should see the stored pointer; x = %d", x)
+	}
+}
+
+func TestPtrConversions(t *testing.T) {
+	x := 7
+	if o := PtrOf(&x).ToOption(); !o.IsSome() || o.Unwrap() != &x {
+		t.Fatal("ToOption on Some should be Some(&x)")
+	}
+	if NonePtr[int]().ToOption().IsSome() {
+		t.Fatal("ToOption on None should be None")
+	}
+	if PtrFromOption(Some(&x)).Unwrap() != &x {
+		t.Fatal("PtrFromOption(Some(&x)) should be Some(&x)")
+	}
+	if PtrFromOption(Some[*int](nil)).IsSome() || PtrFromOption(None[*int]()).IsSome() {
+		t.Fatal("PtrFromOption of None or Some(nil) should be None")
+	}
+	if d := PtrOf(&x).Deref(); d.Unwrap() != 7 {
+		t.Fatalf("Deref() = %v; want Some(7)", d)
+	}
+	if NonePtr[int]().Deref().IsSome() {
+		t.Fatal("Deref on None should be None")
+	}
+}
+
+func TestPtrUnwrapPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("Unwrap on None should panic")
+		}
+	}()
+	NonePtr[int]().Unwrap()
+}
+
+func TestPtrJSON(t *testing.T) {
+	type doc struct {
+		A Ptr[int] `json:"a"`
+		B Ptr[int] `json:"b"`
+	}
+	x := 5
+	b, err := json.Marshal(doc{A: PtrOf(&x)})
+	if err != nil || string(b) != `{"a":5,"b":null}` {
+		t.Fatalf("json.Marshal = %s, %v; want {\"a\":5,\"b\":null}", b, err)
+	}
+	var d doc
+	if err := json.Unmarshal([]byte(`{"a":9,"b":null}`), &d); err != nil {
+		t.Fatal(err)
+	}
+	if *d.A.Unwrap() != 9 || d.B.IsSome() {
+		t.Fatalf("json.Unmarshal: a=%v b=%v; want Some(9), None", d.A, d.B)
+	}
+	if err := json.Unmarshal([]byte(`{"a":"x"}`), &d); err == nil {
+		t.Fatal("json.Unmarshal with wrong type should return error")
+	}
+}
@@ -0,0 +1,335 @@
+package gopt
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math"
+	"unsafe"
+)
+
+// ErrSentinelValue is returned when a Some value would be the reserved sentinel of its type.
+var ErrSentinelValue = errors.New("gopt: value is the None sentinel")
+
+// Sentinelable is the set of types that Sentinel can hold: integer and floating-point types,
+// including named types such as type Celsius float64. Each has a default sentinel, so a
+// Sentinel can always represent None.
+type Sentinelable interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// SentinelValuer lets a type choose the value that Sentinel[T] uses to represent None
+// instead of the default. Implement it on a named type, with a value receiver:
+//
+//	type Celsius int16
+//	func (Celsius) SentinelValue() Celsius { return -32768 }
+type SentinelValuer[T Sentinelable] interface {
+	SentinelValue() T
+}
+
+// Sentinel is an optional value stored without a presence flag: one reserved value of T
+// (the sentinel) represents None. It is the size of T, which halves the memory of large
+// arrays of small numbers compared to Option[T].
+//
+// The sentinel is, in order of precedence:
+//   - T.SentinelValue() if T implements SentinelValuer[T];
+//   - NaN for floating-point types (any NaN is None);
+//   - the minimum value for signed integer types;
+//   - the maximum value for unsigned integer types and uintptr.
+//
+// Named types get the default of their underlying type.
+// The zero value of Sentinel is Some(zero value of T), not None; create None with NoneSentinel.
+//
+// Example:
+//
+//	s := NoneSentinel[float64]()       // stored as NaN
+//	s, err := NewSentinel(21.5)        // Some(21.5)
+//	o := s.ToOption()                  // Option[float64]
+type Sentinel[T Sentinelable] struct {
+	v T
+}
+
+// sentinelOf returns the sentinel value of T. It works from T's arithmetic rather than
+// its exact type, so named numeric types get the same default as their underlying type.
+func sentinelOf[T Sentinelable]() T {
+	var zero T
+	if sv, ok := any(zero).(SentinelValuer[T]); ok {
+		return sv.SentinelValue()
+	}
+	if half := 0.5; T(half) != 0 {
+		nan := math.NaN()
+		return T(nan)
+	}
+	x := zero
+	x--
+	if x > 0 {
+		return x // unsigned: 0-1 wraps to the maximum
+	}
+	minInt := int64(-1) << (unsafe.Sizeof(zero)*8 - 1)
+	return T(minInt)
+}
+
+// isSentinel reports whether v is the sentinel of T. Unnamed built-in types are matched
+// directly so the hot path avoids building the sentinel; any NaN matches.
+func isSentinel[T Sentinelable](v T) bool {
+	switch x := any(v).(type) {
+	case float32:
+		return x != x
+	case float64:
+		return x != x
+	case int:
+		return x == math.MinInt
+	case int8:
+		return x == math.MinInt8
+	case int16:
+		return x == math.MinInt16
+	case int32:
+		return x == math.MinInt32
+	case int64:
+		return x == math.MinInt64
+	case uint:
+		return x == math.MaxUint
+	case uint8:
+		return x == math.MaxUint8
+	case uint16:
+		return x == math.MaxUint16
+	case uint32:
+		return x == math.MaxUint32
+	case uint64:
+		return x == math.MaxUint64
+	case uintptr:
+		return x == ^uintptr(0)
+	}
+	s := sentinelOf[T]()
+	if s != s {
+		return v != v
+	}
+	return v == s
+}
+
+// NewSentinel returns Some(v). It returns ErrSentinelValue if v is the sentinel of T
+// (e.g. NaN for floats).
+//
+// Example:
+//
+//	s, err := NewSentinel(int32(7))            // Some(7), nil
+//	_, err = NewSentinel(int32(math.MinInt32))  // ErrSentinelValue
+func NewSentinel[T Sentinelable](v T) (Sentinel[T], error) {
+	if isSentinel(v) {
+		return Sentinel[T]{}, ErrSentinelValue
+	}
+	return Sentinel[T]{v: v}, nil
+}
+
+// NoneSentinel returns None, stored as the sentinel of T.
+//
+// Example:
+//
+//	s := NoneSentinel[int32]()  // stored as math.MinInt32
+func NoneSentinel[T Sentinelable]() Sentinel[T] {
+	return Sentinel[T]{v: sentinelOf[T]()}
+}
+
+// SentinelFromOption converts o to a Sentinel without loss: None becomes the sentinel
+// and Some(v) is kept. It returns ErrSentinelValue if o is Some(sentinel).
+//
+// Example:
+//
+//	s, err := SentinelFromOption(Some(1.5))  // Some(1.5), nil
+//	s, err = SentinelFromOption(None[float64]())  // None, nil
+func SentinelFromOption[T Sentinelable](o Option[T]) (Sentinel[T], error) {
+	if !o.ok {
+		return NoneSentinel[T](), nil
+	}
+	return NewSentinel(o.value)
+}
+
+// ToOption converts s to Option[T]: None if s holds the sentinel, otherwise Some(value).
+//
+// Example:
+//
+//	NoneSentinel[float64]().ToOption()  // None[float64]()
+func (s Sentinel[T]) ToOption() Option[T] {
+	return FromTuple(s.v, !isSentinel(s.v))
+}
+
+// IsSome returns true if s does not hold the sentinel.
+//
+// Example:
+//
+//	NoneSentinel[int]().IsSome()  // false
+func (s Sentinel[T]) IsSome() bool {
+	return !isSentinel(s.v)
+}
+
+// IsNone returns true if s holds the sentinel.
+//
+// Example:
+//
+//	NoneSentinel[int]().IsNone()  // true
+func (s Sentinel[T]) IsNone() bool {
+	return isSentinel(s.v)
+}
+
+// Get returns the contained value and whether it is present.
+// If s is None, the value is the zero value of T and ok is false.
+//
+// Example:
+//
+//	v, ok := s.Get()
+func (s Sentinel[T]) Get() (T, bool) {
+	if isSentinel(s.v) {
+		var zero T
+		return zero, false
+	}
+	return s.v, true
+}
+
+// Unwrap returns the contained value. It panics with an *UnwrapError if s is None.
+//
+// Example:
+//
+//	v := s.Unwrap()
+func (s Sentinel[T]) Unwrap() T {
+	if isSentinel(s.v) {
+		unwrapPanic[T](noneSource{}, "", nil)
+	}
+	return s.v
+}
+
+// UnwrapOr returns the contained value if Some, otherwise defaultVal.
+//
+// Example:
+//
+//	NoneSentinel[float64]().UnwrapOr(0)  // 0
+func (s Sentinel[T]) UnwrapOr(defaultVal T) T {
+	if isSentinel(s.v) {
+		return defaultVal
+	}
+	return s.v
+}
+
+// UnwrapOrElse returns the contained value if Some, otherwise the result of calling fn.
+//
+// Example:
+//
+//	NoneSentinel[float64]().UnwrapOrElse(func() float64 { return 0 })  // 0
+func (s Sentinel[T]) UnwrapOrElse(fn func() T) T {
+	if isSentinel(s.v) {
+		return fn()
+	}
+	return s.v
+}
+
+// Expect returns the contained value if Some. It panics with an *UnwrapError carrying msg if None.
+//
+// Example:
+//
+//	v := s.Expect("reading required")
+func (s Sentinel[T]) Expect(msg string) T {
+	if isSentinel(s.v) {
+		unwrapPanic[T](noneSource{}, msg, nil)
+	}
+	return s.v
+}
+
+// Filter returns s if Some and pred(value) is true, otherwise returns None.
+//
+// Example:
+//
+//	s.Filter(func(v float64) bool { return v >= 0 })
+func (s Sentinel[T]) Filter(pred func(T) bool) Sentinel[T] {
+	if isSentinel(s.v) || pred(s.v) {
+		return s
+	}
+	return NoneSentinel[T]()
+}
+
+// Or returns s if Some, otherwise returns other.
+//
+// Example:
+//
+//	NoneSentinel[int]().Or(s)  // s
+func (s Sentinel[T]) Or(other Sentinel[T]) Sentinel[T] {
+	if isSentinel(s.v) {
+		return other
+	}
+	return s
+}
+
+// OrElse returns s if Some, otherwise returns fn().
+//
+// Example:
+//
+//	NoneSentinel[int]().OrElse(func() Sentinel[int] { return s })
+func (s Sentinel[T]) OrElse(fn func() Sentinel[T]) Sentinel[T] {
+	if isSentinel(s.v) {
+		return fn()
+	}
+	return s
+}
+
+// Tap calls fn with the contained value if Some, then returns s unchanged.
+//
+// Example:
+//
+//	s.Tap(func(v float64) { log.Println(v) })
+func (s Sentinel[T]) Tap(fn func(T)) Sentinel[T] {
+	if !isSentinel(s.v) {
+		fn(s.v)
+	}
+	return s
+}
+
+// ToPointer returns nil if None, or a pointer to a copy of the value if Some.
+//
+// Example:
+//
+//	p := s.ToPointer()
+func (s Sentinel[T]) ToPointer() *T {
+	if isSentinel(s.v) {
+		return nil
+	}
+	v := s.v
+	return &v
+}
+
+// MarshalJSON implements encoding/json.Marshaler. None encodes as null; Some(v) encodes as v.
+//
+// Example:
+//
+//	b, _ := json.Marshal(NoneSentinel[float64]())  // []byte("null")
+func (s Sentinel[T]) MarshalJSON() ([]byte, error) {
+	if isSentinel(s.v) {
+		return []byte("null"), nil
+	}
+	return json.Marshal(s.v)
+}
+
+// UnmarshalJSON implements encoding/json.Unmarshaler. Null, empty, or
+// whitespace-only input decodes as None; otherwise decodes into Some(v).
+// It returns ErrSentinelValue if the decoded value is the sentinel.
+//
+// Example:
+//
+//	var s Sentinel[int32]
+//	json.Unmarshal([]byte("7"), &s)  // s = Some(7)
+func (s *Sentinel[T]) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		*s = NoneSentinel[T]()
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	next, err := NewSentinel(v)
+	if err != nil {
+		return err
+	}
+	*s = next
+	return nil
+}
@@ -0,0 +1,155 @@
+package gopt
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"unsafe"
+)
+
+type celsius int16
+
+func (celsius) SentinelValue() celsius { return -32768 }
+
+// Named numeric types without SentinelValue use the default of their underlying type.
+type (
+	temperature float64
+	sensorID    int32
+	counter     uint16
+)
+
+func TestSentinelSize(t *testing.T) {
+	if got := unsafe.Sizeof(Sentinel[float64]{}); got != 8 {
+		t.Fatalf("Sizeof(Sentinel[float64]) = %d; want 8", got)
+	}
+	if got := unsafe.Sizeof(Sentinel[int32]{}); got != 4 {
+		t.Fatalf("Sizeof(Sentinel[int32]) = %d; want 4", got)
+	}
+}
+
+func TestNewSentinel(t *testing.T) {
+	s, err := NewSentinel(21.5)
+	if err != nil || !s.IsSome() || s.Unwrap() != 21.5 {
+		t.Fatalf("NewSentinel(21.5) = %v, %v; want Some(21.5), nil", s.ToOption(), err)
+	}
+	if _, err := NewSentinel(math.NaN()); !errors.Is(err, ErrSentinelValue) {
+		t.Fatalf("NewSentinel(NaN) err = %v; want ErrSentinelValue", err)
+	}
+	if _, err := NewSentinel(int32(math.MinInt32)); !errors.Is(err, ErrSentinelValue) {
+		t.Fatalf("NewSentinel(MinInt32) err = %v; want ErrSentinelValue", err)
+	}
+	if _, err := NewSentinel(uint8(255)); !errors.Is(err, ErrSentinelValue) {
+		t.Fatalf("NewSentinel(uint8(255)) err = %v; want ErrSentinelValue", err)
+	}
+	if _, err := NewSentinel(celsius(-32768)); !errors.Is(err, ErrSentinelValue) {
+		t.Fatalf("NewSentinel(celsius sentinel) err = %v; want ErrSentinelValue", err)
+	}
+	if s, err := NewSentinel(celsius(-40)); err != nil || s.Unwrap() != -40 {
+		t.Fatalf("NewSentinel(celsius(-40)) = %v, %v; want Some(-40), nil", s.ToOption(), err)
+	}
+	if _, err := NewSentinel(temperature(math.NaN())); !errors.Is(err, ErrSentinelValue) {
+		t.Fatalf("NewSentinel(temperature(NaN)) err = %v; want ErrSentinelValue", err)
+	}
+	if _, err := NewSentinel(sensorID(math.MinInt32)); !errors.Is(err, ErrSentinelValue) {
+		t.Fatalf("NewSentinel(sensorID(MinInt32)) err = %v; want ErrSentinelValue", err)
+	}
+}
+
+func TestNoneSentinel(t *testing.T) {
+	if !NoneSentinel[float64]().IsNone() || !NoneSentinel[int]().IsNone() || !NoneSentinel[celsius]().IsNone() {
+		t.Fatal("NoneSentinel should be None")
+	}
+	if _, ok := NoneSentinel[float32]().Get(); ok {
+		t.Fatal("Get on None should return false")
+	}
+	if !NoneSentinel[temperature]().IsNone() || !NoneSentinel[sensorID]().IsNone() || !NoneSentinel[counter]().IsNone() {
+		t.Fatal("NoneSentinel of a named numeric type should be None")
+	}
+	if v := NoneSentinel[sensorID]().v; v != math.MinInt32 {
+		t.Fatalf("NoneSentinel[sensorID] stored %d; want MinInt32", v)
+	}
+	if v := NoneSentinel[counter]().v; v != math.MaxUint16 {
+		t.Fatalf("NoneSentinel[counter] stored %d; want MaxUint16", v)
+	}
+	var zero Sentinel[sensorID]
+	if f := zero.Filter(func(sensorID) bool { return false }); f.IsSome() {
+		t.Fatal("Filter on a named type should give None")
+	}
+}
+
+func TestSentinelOptionRoundTrip(t *testing.T) {
+	for _, o := range []Option[int32]{Some[int32](0), Some[int32](-5), None[int32]()} {
+		s, err := SentinelFromOption(o)
+		if err != nil {
+			t.Fatalf("SentinelFromOption(%v) err = %v", o, err)
+		}
+		if back := s.ToOption(); !Equals(back, o) {
+			t.Fatalf("round trip of %v = %v", o, back)
+		}
+	}
+	if _, err := SentinelFromOption(Some[int32](math.MinInt32)); !errors.Is(err, ErrSentinelValue) {
+		t.Fatalf("SentinelFromOption(Some(sentinel)) err = %v; want ErrSentinelValue", err)
+	}
+}
+
+func TestSentinelMethods(t *testing.T) {
+	some, _ := NewSentinel(4.0)
+	none := NoneSentinel[float64]()
+	if none.UnwrapOr(1) != 1 || some.UnwrapOr(1) != 4 {
+		t.Fatal("UnwrapOr mismatch")
+	}
+	if none.UnwrapOrElse(func() float64 { return 2 }) != 2 {
+		t.Fatal("UnwrapOrElse on None should call fn")
+	}
+	if some.Expect("x") != 4 {
+		t.Fatal("Expect on Some should return value")
+	}
+	positive := func(v float64) bool { return v > 0 }
+	negative := func(v float64) bool { return v < 0 }
+	if !some.Filter(positive).IsSome() || some.Filter(negative).IsSome() || none.Filter(positive).IsSome() {
+		t.Fatal("Filter mismatch")
+	}
+	if none.Or(some).Unwrap() != 4 || some.Or(none).Unwrap() != 4 {
+		t.Fatal("Or mismatch")
+	}
+	if none.OrElse(func() Sentinel[float64] { return some }).Unwrap() != 4 {
+		t.Fatal("OrElse on None should call fn")
+	}
+	var got float64
+	some.Tap(func(v float64) { got = v })
+	none.Tap(func(v float64) { got = -1 })
+	if got != 4 {
+		t.Fatalf("Tap: got %v; want 4", got)
+	}
+	if p := some.ToPointer(); p == nil || *p != 4 || none.ToPointer() != nil {
+		t.Fatal("ToPointer mismatch")
+	}
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Fatal("Unwrap on None should panic")
+			}
+		}()
+		none.Unwrap()
+	}()
+}
+
+func TestSentinelJSON(t *testing.T) {
+	some, _ := NewSentinel(int32(7))
+	b, err := json.Marshal([]Sentinel[int32]{some, NoneSentinel[int32]()})
+	if err != nil || string(b) != "[7,null]" {
+		t.Fatalf("json.Marshal = %s, %v; want [7,null]", b, err)
+	}
+	var out []Sentinel[int32]
+	if err := json.Unmarshal([]byte("[1,null]"), &out); err != nil {
+		t.Fatal(err)
+	}
+	if out[0].Unwrap() != 1 || out[1].IsSome() {
+		t.Fatalf("json.Unmarshal = %v, %v; want Some(1), None", out[0].ToOption(), out[1].ToOption())
+	}
+	var s Sentinel[int32]
+	if err := json.Unmarshal([]byte("-2147483648"), &s); !errors.Is(err, ErrSentinelValue) {
+		t.Fatalf("json.Unmarshal(sentinel) err = %v; want ErrSentinelValue", err)
+	}
+}
@@ -0,0 +1,335 @@
+package gopt
+
+import (
+	"cmp"
+	"math/bits"
+	"sync/atomic"
+)
+
+// OptionSlice is a columnar sequence of optional values: values are stored contiguously
+// in a []T and presence is tracked in a separate validity bitmap (one bit per element),
+// like an Arrow nullable column. Compared to []Option[T] it avoids the per-element bool
+// and padding, and scans over present values skip whole 64-element blocks at a time.
+// None slots always hold the zero value of T, so sums can run over the values directly.
+// The zero value is an empty slice ready to use. Reads, including Slice, may run concurrently;
+// Set and Append may not run concurrently with any other call on the same OptionSlice.
+//
+// Example:
+//
+//	var s OptionSlice[float64]
+//	s.Append(Some(1.5), None[float64](), Some(2.5))
+//	s.Get(1)         // None[float64]()
+//	SliceSum(&s)     // 4
+//	s.NullCount()    // 1
+type OptionSlice[T any] struct {
+	values []T
+	valid  []uint64
+	off    int           // bit index of values[0] in valid
+	refs   *atomic.Int32 // number of OptionSlices using values and valid; copy before writing if > 1
+}
+
+// newSliceRefs returns a reference count for storage owned by one OptionSlice.
+func newSliceRefs() *atomic.Int32 {
+	refs := new(atomic.Int32)
+	refs.Store(1)
+	return refs
+}
+
+// NewOptionSlice returns an empty OptionSlice with room for capacity elements.
+//
+// Example:
+//
+//	s := NewOptionSlice[int](1024)
+func NewOptionSlice[T any](capacity int) *OptionSlice[T] {
+	return &OptionSlice[T]{
+		values: make([]T, 0, capacity),
+		valid:  make([]uint64, 0, (capacity+63)/64),
+		refs:   newSliceRefs(),
+	}
+}
+
+// OptionSliceOf returns an OptionSlice holding a copy of opts.
+//
+// Example:
+//
+//	s := OptionSliceOf([]Option[int]{Some(1), None[int]()})
+func OptionSliceOf[T any](opts []Option[T]) *OptionSlice[T] {
+	s := NewOptionSlice[T](len(opts))
+	s.Append(opts...)
+	return s
+}
+
+// Len returns the number of elements, Some or None.
+//
+// Example:
+//
+//	OptionSliceOf([]Option[int]{Some(1), None[int]()}).Len()  // 2
+func (s *OptionSlice[T]) Len() int {
+	return len(s.values)
+}
+
+// NullCount returns the number of None elements.
+//
+// Example:
+//
+//	OptionSliceOf([]Option[int]{Some(1), None[int]()}).NullCount()  // 1
+func (s *OptionSlice[T]) NullCount() int {
+	return len(s.values) - s.CountSome()
+}
+
+// CountSome returns the number of Some elements.
+//
+// Example:
+//
+//	OptionSliceOf([]Option[int]{Some(1), None[int]()}).CountSome()  // 1
+func (s *OptionSlice[T]) CountSome() int {
+	n := 0
+	for k := 0; k < len(s.values); k += 64 {
+		n += bits.OnesCount64(s.block(k))
+	}
+	return n
+}
+
+// Append adds opts to the end of s.
+//
+// Example:
+//
+//	s.Append(Some(1), None[int]())
+func (s *OptionSlice[T]) Append(opts ...Option[T]) {
+	if s.refs == nil {
+		s.refs = newSliceRefs()
+	} else if s.refs.Load() > 1 {
+		s.detach()
+	}
+	for _, o := range opts {
+		i := s.off + len(s.values)
+		if i>>6 >= len(s.valid) {
+			s.valid = append(s.valid, 0)
+		}
+		var v T
+		if o.ok {
+			v = o.value
+		}
+		s.values = append(s.values, v)
+		if o.ok {
+			s.valid[i>>6] |= 1 << (i & 63)
+		} else {
+			s.valid[i>>6] &^= 1 << (i & 63)
+		}
+	}
+}
+
+// Set replaces the element at index i with o. It panics if i is out of range.
+//
+// Example:
+//
+//	s.Set(0, None[int]())
+func (s *OptionSlice[T]) Set(i int, o Option[T]) {
+	_ = s.values[i] // bounds check before any copy
+	if s.refs.Load() > 1 {
+		s.detach()
+	}
+	var v T
+	if o.ok {
+		v = o.value
+	}
+	s.values[i] = v
+	j := s.off + i
+	if o.ok {
+		s.valid[j>>6] |= 1 << (j & 63)
+	} else {
+		s.valid[j>>6] &^= 1 << (j & 63)
+	}
+}
+
+// Get returns the element at index i. It panics if i is out of range.
+//
+// Example:
+//
+//	s.Get(0)  // Some(1)
+func (s *OptionSlice[T]) Get(i int) Option[T] {
+	v := s.values[i] // bounds check
+	j := s.off + i
+	return FromTuple(v, s.valid[j>>6]&(1<<(j&63)) != 0)
+}
+
+// Values returns a copy of the values, with the zero value of T in None slots.
+//
+// Example:
+//
+//	raw := s.Values()
+func (s *OptionSlice[T]) Values() []T {
+	out := make([]T, len(s.values))
+	copy(out, s.values)
+	return out
+}
+
+// All returns an iterator over index/element pairs, compatible with iter.Seq2[int, Option[T]].
+//
+// Example:
+//
+//	s.All()(func(i int, o Option[int]) bool { fmt.Println(i, o.UnwrapOr(-1)); return true })
+func (s *OptionSlice[T]) All() func(yield func(int, Option[T]) bool) {
+	return func(yield func(int, Option[T]) bool) {
+		for i := range s.values {
+			if !yield(i, s.Get(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Slice returns the elements in [i, j) as a new OptionSlice without copying them.
+// The result and s share storage copy-on-write: a Set or Append on either one while the
+// storage is still shared first gives it its own copy, so changes to one are never visible
+// in the other. Slice does not modify s, so views can be taken concurrently.
+// It panics if the indices are out of range.
+//
+// Example:
+//
+//	head := s.Slice(0, 10)
+//	head.Set(0, None[int]())  // s is unchanged
+func (s *OptionSlice[T]) Slice(i, j int) *OptionSlice[T] {
+	values := s.values[i:j:j]
+	start := s.off + i
+	if s.refs != nil {
+		s.refs.Add(1)
+	}
+	return &OptionSlice[T]{
+		values: values,
+		valid:  s.valid[start>>6:],
+		off:    start & 63,
+		refs:   s.refs,
+	}
+}
+
+// Options returns the elements as a new []Option[T].
+//
+// Example:
+//
+//	opts := s.Options()
+func (s *OptionSlice[T]) Options() []Option[T] {
+	out := make([]Option[T], len(s.values))
+	for i := range out {
+		out[i] = s.Get(i)
+	}
+	return out
+}
+
+// detach gives s its own copy of values and valid, with the bitmap realigned to bit 0.
+// Both are copied together so s never pairs values with another slice's bitmap.
+func (s *OptionSlice[T]) detach() {
+	values := make([]T, len(s.values), cap(s.values)+1)
+	copy(values, s.values)
+	valid := make([]uint64, (len(values)+63)/64, (cap(values)+63)/64)
+	for k := 0; k < len(values); k += 64 {
+		valid[k>>6] = s.block(k)
+	}
+	if s.refs != nil {
+		s.refs.Add(-1)
+	}
+	s.values, s.valid, s.off, s.refs = values, valid, 0, newSliceRefs()
+}
+
+// block returns the validity bits of elements [k, k+64), bit 0 being element k.
+// Bits past the end of s are zero.
+func (s *OptionSlice[T]) block(k int) uint64 {
+	i := s.off + k
+	w := s.valid[i>>6] >> (i & 63)
+	if sh := i & 63; sh != 0 && i>>6+1 < len(s.valid) {
+		w |= s.valid[i>>6+1] << (64 - sh)
+	}
+	if rest := len(s.values) - k; rest < 64 {
+		w &= 1<<rest - 1
+	}
+	return w
+}
+
+// SliceSum returns the sum of the Some elements of s; None elements are skipped.
+// An empty or all-None slice sums to 0. Additions are grouped for speed, so float
+// results may differ in the last bits from a sequential loop.
+//
+// Example:
+//
+//	SliceSum(OptionSliceOf([]Option[int]{Some(1), None[int](), Some(2)}))  // 3
+func SliceSum[N Number](s *OptionSlice[N]) N {
+	// None slots hold zero, so no bitmap lookups are needed. Four independent
+	// accumulators let the CPU overlap the additions.
+	var s0, s1, s2, s3 N
+	vals := s.values
+	for len(vals) >= 4 {
+		s0 += vals[0]
+		s1 += vals[1]
+		s2 += vals[2]
+		s3 += vals[3]
+		vals = vals[4:]
+	}
+	for _, v := range vals {
+		s0 += v
+	}
+	return s0 + s1 + s2 + s3
+}
+
+// SliceMin returns the smallest Some element of s, or None if s has no Some elements.
+//
+// Example:
+//
+//	SliceMin(OptionSliceOf([]Option[int]{Some(3), None[int](), Some(2)}))  // Some(2)
+func SliceMin[T cmp.Ordered](s *OptionSlice[T]) Option[T] {
+	var best T
+	found := false
+	for k := 0; k < len(s.values); k += 64 {
+		vals := s.values[k:min(k+64, len(s.values))]
+		for w := s.block(k); w != 0; w &= w - 1 {
+			if v := vals[bits.TrailingZeros64(w)]; !found || v < best {
+				best, found = v, true
+			}
+		}
+	}
+	return FromTuple(best, found)
+}
+
+// SliceMax returns the largest Some element of s, or None if s has no Some elements.
+//
+// Example:
+//
+//	SliceMax(OptionSliceOf([]Option[int]{Some(3), None[int](), Some(2)}))  // Some(3)
+func SliceMax[T cmp.Ordered](s *OptionSlice[T]) Option[T] {
+	var best T
+	found := false
+	for k := 0; k < len(s.values); k += 64 {
+		vals := s.values[k:min(k+64, len(s.values))]
+		for w := s.block(k); w != 0; w &= w - 1 {
+			if v := vals[bits.TrailingZeros64(w)]; !found || v > best {
+				best, found = v, true
+			}
+		}
+	}
+	return FromTuple(best, found)
+}
+
+// SliceMean returns the arithmetic mean of the Some elements of s as a float64,
+// or None if s has no Some elements.
+//
+// Example:
+//
+//	SliceMean(OptionSliceOf([]Option[int]{Some(1), None[int](), Some(2)}))  // Some(1.5)
+func SliceMean[N Number](s *OptionSlice[N]) Option[float64] {
+	n := s.CountSome()
+	if n == 0 {
+		return None[float64]()
+	}
+	var s0, s1, s2, s3 float64
+	vals := s.values
+	for len(vals) >= 4 {
+		s0 += float64(vals[0])
+		s1 += float64(vals[1])
+		s2 += float64(vals[2])
+		s3 += float64(vals[3])
+		vals = vals[4:]
+	}
+	for _, v := range vals {
+		s0 += float64(v)
+	}
+	return Some((s0 + s1 + s2 + s3) / float64(n))
+}
@@ -0,0 +1,184 @@
+package gopt
+
+import (
+	"sync"
+	"testing"
+)
+
+func buildOptionSlice(n int) ([]Option[int], *OptionSlice[int]) {
+	opts := make([]Option[int], n)
+	for i := range opts {
+		if i%3 != 0 {
+			opts[i] = Some(i)
+		}
+	}
+	return opts, OptionSliceOf(opts)
+}
+
+func TestOptionSliceBasics(t *testing.T) {
+	var s OptionSlice[int]
+	s.Append(Some(1), None[int](), Some(3))
+	if s.Len() != 3 || s.NullCount() != 1 || s.CountSome() != 2 {
+		t.Fatalf("Len/NullCount/CountSome = %d/%d/%d; want 3/1/2", s.Len(), s.NullCount(), s.CountSome())
+	}
+	if s.Get(0).Unwrap() != 1 || s.Get(1).IsSome() || s.Get(2).Unwrap() != 3 {
+		t.Fatalf("Get mismatch: %v", s.Options())
+	}
+	s.Set(1, Some(2))
+	s.Set(0, None[int]())
+	if s.Get(0).IsSome() || s.Get(1).Unwrap() != 2 || s.Values()[0] != 0 {
+		t.Fatalf("Set mismatch: %v", s.Options())
+	}
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Fatal("Get out of range should panic")
+			}
+		}()
+		s.Get(3)
+	}()
+}
+
+func TestOptionSliceRoundTrip(t *testing.T) {
+	opts, s := buildOptionSlice(200)
+	back := s.Options()
+	for i := range opts {
+		if !Equals(opts[i], back[i]) {
+			t.Fatalf("element %d = %v; want %v", i, back[i], opts[i])
+		}
+	}
+	if s.NullCount() != 67 {
+		t.Fatalf("NullCount() = %d; want 67", s.NullCount())
+	}
+	n := 0
+	s.All()(func(i int, o Option[int]) bool {
+		if !Equals(o, opts[i]) {
+			t.Fatalf("All yielded %v at %d; want %v", o, i, opts[i])
+		}
+		n++
+		return i < 99
+	})
+	if n != 100 {
+		t.Fatalf("All should stop when yield returns false; visited %d", n)
+	}
+}
+
+func TestOptionSliceSlice(t *testing.T) {
+	opts, s := buildOptionSlice(200)
+	v := s.Slice(70, 150)
+	if v.Len() != 80 {
+		t.Fatalf("Slice Len() = %d; want 80", v.Len())
+	}
+	for i := 0; i < v.Len(); i++ {
+		if !Equals(v.Get(i), opts[70+i]) {
+			t.Fatalf("view element %d = %v; want %v", i, v.Get(i), opts[70+i])
+		}
+	}
+	want := 0
+	for _, o := range opts[70:150] {
+		want += o.UnwrapOr(0)
+	}
+	if got := SliceSum(v); got != want {
+		t.Fatalf("SliceSum(view) = %d; want %d", got, want)
+	}
+
+	v.Set(0, Some(-1))
+	if !Equals(s.Get(70), opts[70]) {
+		t.Fatal("Set on a view must not be visible in the parent")
+	}
+	if v.Get(0).Unwrap() != -1 {
+		t.Fatalf("view Get(0) = %v; want Some(-1)", v.Get(0))
+	}
+	v.Append(None[int]())
+	if v.Len() != 81 || v.Get(80).IsSome() {
+		t.Fatal("Append on a view should add the element")
+	}
+	if !Equals(s.Get(150), opts[150]) {
+		t.Fatal("Append on a view must not overwrite the parent")
+	}
+
+	w := s.Slice(10, 20)
+	s.Set(10, None[int]())
+	s.Append(Some(1000))
+	if !Equals(w.Get(0), opts[10]) {
+		t.Fatalf("view Get(0) = %v after parent Set; want %v", w.Get(0), opts[10])
+	}
+	for i := 0; i < w.Len(); i++ {
+		if !Equals(w.Get(i), opts[10+i]) {
+			t.Fatalf("view element %d = %v after parent mutation; want %v", i, w.Get(i), opts[10+i])
+		}
+	}
+	if s.Get(10).IsSome() || s.Get(200).Unwrap() != 1000 {
+		t.Fatalf("parent Get(10), Get(200) = %v, %v; want None, Some(1000)", s.Get(10), s.Get(200))
+	}
+}
+
+func TestOptionSliceConcurrentSlice(t *testing.T) {
+	opts, s := buildOptionSlice(200)
+	views := make([]*OptionSlice[int], 8)
+	var wg sync.WaitGroup
+	for i := range views {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			views[i] = s.Slice(i, i+100)
+		}()
+	}
+	wg.Wait()
+	for i, v := range views {
+		v.Set(0, Some(-i))
+		if !Equals(s.Get(i), opts[i]) {
+			t.Fatalf("parent Get(%d) = %v after Set on a view; want %v", i, s.Get(i), opts[i])
+		}
+	}
+	for i, v := range views {
+		if v.Get(0).Unwrap() != -i || !Equals(v.Get(1), opts[i+1]) {
+			t.Fatalf("view %d Get(0), Get(1) = %v, %v; want Some(%d), %v", i, v.Get(0), v.Get(1), -i, opts[i+1])
+		}
+	}
+}
+
+func TestOptionSliceValuesCopy(t *testing.T) {
+	s := OptionSliceOf([]Option[int]{Some(1), None[int](), Some(2)})
+	raw := s.Values()
+	raw[1] = 100
+	if got := SliceSum(s); got != 3 {
+		t.Fatalf("SliceSum after writing into Values() = %d; want 3", got)
+	}
+	if got := SliceMean(s); got.Unwrap() != 1.5 {
+		t.Fatalf("SliceMean after writing into Values() = %v; want Some(1.5)", got)
+	}
+}
+
+func TestOptionSliceAggregates(t *testing.T) {
+	var empty OptionSlice[float64]
+	if SliceSum(&empty) != 0 || SliceMin(&empty).IsSome() || SliceMax(&empty).IsSome() || SliceMean(&empty).IsSome() {
+		t.Fatal("aggregates of an empty slice should be 0/None")
+	}
+	s := OptionSliceOf([]Option[float64]{Some(3.0), None[float64](), Some(1.0), Some(2.0), None[float64]()})
+	if got := SliceSum(s); got != 6 {
+		t.Fatalf("SliceSum = %v; want 6", got)
+	}
+	if got := SliceMin(s); got.Unwrap() != 1 {
+		t.Fatalf("SliceMin = %v; want Some(1)", got)
+	}
+	if got := SliceMax(s); got.Unwrap() != 3 {
+		t.Fatalf("SliceMax = %v; want Some(3)", got)
+	}
+	if got := SliceMean(s); got.Unwrap() != 2 {
+		t.Fatalf("SliceMean = %v; want Some(2)", got)
+	}
+
+	opts, big := buildOptionSlice(1000)
+	sum := 0
+	for _, o := range opts {
+		sum += o.UnwrapOr(0)
+	}
+	if got := SliceSum(big); got != sum {
+		t.Fatalf("SliceSum(1000) = %d; want %d", got, sum)
+	}
+	if got := SliceMax(big); got.Unwrap() != 998 {
+		t.Fatalf("SliceMax(1000) = %v; want Some(998)", got)
+	}
+}
@@ -0,0 +1,130 @@
+package gopt
+
+import (
+	"context"
+	"log/slog"
+)
+
+// LogNoneMode selects how Attr logs None.
+type LogNoneMode int
+
+const (
+	// LogNoneNull logs None as a null value (nil in slog terms), like LogValue.
+	LogNoneNull LogNoneMode = iota
+	// LogNoneOmit leaves the attribute out of the record.
+	LogNoneOmit
+)
+
+// LogValue implements slog.LogValuer: Some logs as its value and None as null.
+// Use Attr with LogNoneOmit or OmitNoneHandler to leave None out instead.
+//
+// Example:
+//
+//	slog.Info("login", "user", Some("ann"))  // user=ann
+//	slog.Info("login", "user", None[string]())  // user=<nil>
+func (o Option[T]) LogValue() slog.Value {
+	if !o.ok {
+		return slog.AnyValue(nil)
+	}
+	return slog.AnyValue(o.value)
+}
+
+// LogValue implements slog.LogValuer like Option's LogValue; Some logs the pointed-to value.
+//
+// Example:
+//
+//	slog.Info("load", "cfg", PtrOf(&cfg))
+func (p Ptr[T]) LogValue() slog.Value {
+	if p.p == nil {
+		return slog.AnyValue(nil)
+	}
+	return slog.AnyValue(*p.p)
+}
+
+// LogValue implements slog.LogValuer like Option's LogValue.
+//
+// Example:
+//
+//	slog.Info("stats", "p99", latency)
+func (s Sentinel[T]) LogValue() slog.Value {
+	return s.ToOption().LogValue()
+}
+
+// Attr returns an slog.Attr for o, for use with slog.LogAttrs and Logger.With. With LogNoneOmit
+// a None gives the empty Attr, which slog handlers ignore; with LogNoneNull it logs as null.
+// The value stays a LogValuer until the handler resolves it, so OmitNoneHandler can see None.
+//
+// Example:
+//
+//	logger.LogAttrs(ctx, slog.LevelInfo, "login", Attr("user", u.Name, LogNoneNull), Attr("org", u.Org, LogNoneOmit))
+func Attr[T any](key string, o Option[T], mode LogNoneMode) slog.Attr {
+	if !o.ok && mode == LogNoneOmit {
+		return slog.Attr{}
+	}
+	return slog.Any(key, o)
+}
+
+// OmitNoneHandler returns a slog.Handler that passes records to h without attributes whose
+// value is a None Option, Ptr or Sentinel, including attributes inside groups and those
+// added with WithAttrs.
+//
+// Example:
+//
+//	logger := slog.New(OmitNoneHandler(slog.NewJSONHandler(os.Stdout, nil)))
+func OmitNoneHandler(h slog.Handler) slog.Handler {
+	return omitNoneHandler{h}
+}
+
+type omitNoneHandler struct {
+	h slog.Handler
+}
+
+func (o omitNoneHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return o.h.Enabled(ctx, level)
+}
+
+func (o omitNoneHandler) Handle(ctx context.Context, r slog.Record) error {
+	out := slog.NewRecord(r.Time, r.Level, r.Message, r.PC)
+	r.Attrs(func(a slog.Attr) bool {
+		if a, ok := omitNone(a); ok {
+			out.AddAttrs(a)
+		}
+		return true
+	})
+	return o.h.Handle(ctx, out)
+}
+
+func (o omitNoneHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	kept := make([]slog.Attr, 0, len(attrs))
+	for _, a := range attrs {
+		if a, ok := omitNone(a); ok {
+			kept = append(kept, a)
+		}
+	}
+	return omitNoneHandler{o.h.WithAttrs(kept)}
+}
+
+func (o omitNoneHandler) WithGroup(name string) slog.Handler {
+	return omitNoneHandler{o.h.WithGroup(name)}
+}
+
+// omitNone returns a with None values removed from it and its groups, and false if a
+// itself is None.
+func omitNone(a slog.Attr) (slog.Attr, bool) {
+	switch a.Value.Kind() {
+	case slog.KindLogValuer:
+		if n, ok := a.Value.Any().(interface{ IsNone() bool }); ok && n.IsNone() {
+			return a, false
+		}
+	case slog.KindGroup:
+		group := a.Value.Group()
+		kept := make([]slog.Attr, 0, len(group))
+		for _, g := range group {
+			if g, ok := omitNone(g); ok {
+				kept = append(kept, g)
+			}
+		}
+		return slog.Attr{Key: a.Key, Value: slog.GroupValue(kept...)}, true
+	}
+	return a, true
+}
@@ -0,0 +1,75 @@
+package gopt
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+// newTestLogger returns a logger writing JSON without time and level to buf.
+func newTestLogger(buf *bytes.Buffer, wrap func(slog.Handler) slog.Handler) *slog.Logger {
+	h := slog.Handler(slog.NewJSONHandler(buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && (a.Key == slog.TimeKey || a.Key == slog.LevelKey) {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	if wrap != nil {
+		h = wrap(h)
+	}
+	return slog.New(h)
+}
+
+func TestLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	log := newTestLogger(&buf, nil)
+	log.Info("m", "a", Some(1), "b", None[int](), "c", Some(Some("x")))
+	if got, want := strings.TrimSpace(buf.String()), `{"msg":"m","a":1,"b":null,"c":"x"}`; got != want {
+		t.Fatalf("log = %s; want %s", got, want)
+	}
+
+	buf.Reset()
+	log.LogAttrs(context.Background(), slog.LevelInfo, "m",
+		Attr("a", Some(1), LogNoneOmit), Attr("b", None[int](), LogNoneOmit), Attr("c", None[int](), LogNoneNull),
+		slog.Group("g", Attr("d", None[int](), LogNoneOmit), Attr("e", Some(2), LogNoneOmit)),
+	)
+	if got, want := strings.TrimSpace(buf.String()), `{"msg":"m","a":1,"c":null,"g":{"e":2}}`; got != want {
+		t.Fatalf("log with Attr modes = %s; want %s", got, want)
+	}
+}
+
+func TestLogValuePtrSentinel(t *testing.T) {
+	var buf bytes.Buffer
+	x := 7
+	s, _ := NewSentinel(3)
+	newTestLogger(&buf, nil).Info("m", "p", PtrOf(&x), "q", NonePtr[int](), "s", s)
+	if got, want := strings.TrimSpace(buf.String()), `{"msg":"m","p":7,"q":null,"s":3}`; got != want {
+		t.Fatalf("log = %s; want %s", got, want)
+	}
+}
+
+func TestOmitNoneHandler(t *testing.T) {
+	var buf bytes.Buffer
+	log := newTestLogger(&buf, OmitNoneHandler).With("w", None[int](), "v", Some(2))
+	log.LogAttrs(context.Background(), slog.LevelInfo, "m",
+		Attr("a", Some("x"), LogNoneNull),
+		Attr("b", None[string](), LogNoneNull),
+		slog.Group("g", "c", None[int](), "d", NonePtr[int](), "e", 1),
+		slog.Int("f", 0),
+	)
+	if got, want := strings.TrimSpace(buf.String()), `{"msg":"m","v":2,"a":"x","g":{"e":1},"f":0}`; got != want {
+		t.Fatalf("log = %s; want %s", got, want)
+	}
+	buf.Reset()
+	log.WithGroup("h").Info("m", "x", None[int](), "y", Some(1))
+	if got, want := strings.TrimSpace(buf.String()), `{"msg":"m","v":2,"h":{"y":1}}`; got != want {
+		t.Fatalf("log with group = %s; want %s", got, want)
+	}
+	if !log.Enabled(context.Background(), slog.LevelInfo) || log.Enabled(context.Background(), slog.LevelDebug) {
+		t.Fatal("OmitNoneHandler should delegate Enabled")
+	}
+}
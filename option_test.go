@@ -303,6 +303,18 @@ func TestCond(t *testing.T) {
 	}
 }
 
+func TestCondFunc(t *testing.T) {
+	var p *int
+	if CondFunc(p != nil, func() int { return *p }).IsSome() {
+		t.Fatal("CondFunc(false, fn) should be None without calling fn")
+	}
+	x := 7
+	p = &x
+	if v := CondFunc(p != nil, func() int { return *p }); v.UnwrapOr(0) != 7 {
+		t.Fatalf("CondFunc(true, fn) = %v; want Some(7)", v)
+	}
+}
+
 func TestToPointer(t *testing.T) {
 	if p := None[int]().ToPointer(); p != nil {
 		t.Fatalf("None().ToPointer() = %v; want nil", p)
@@ -403,3 +415,158 @@ func TestUnwrapPanics(t *testing.T) {
 	}()
 	None[int]().Unwrap()
 }
+
+func TestTake(t *testing.T) {
+	o := Some(42)
+	if v := o.Take(); !v.IsSome() || v.Unwrap() != 42 {
+		t.Fatalf("Take() = %v; want Some(42)", v)
+	}
+	if o.IsSome() {
+		t.Fatal("Take should leave None")
+	}
+	if o.Take().IsSome() {
+		t.Fatal("Take on None should return None")
+	}
+}
+
+func TestReplace(t *testing.T) {
+	o := Some(1)
+	if old := o.Replace(2); old.Unwrap() != 1 || o.Unwrap() != 2 {
+		t.Fatalf("Replace(2) = %v, o = %v; want Some(1), Some(2)", old, o)
+	}
+	var n Option[int]
+	if old := n.Replace(3); old.IsSome() || n.Unwrap() != 3 {
+		t.Fatalf("Replace on None = %v, o = %v; want None, Some(3)", old, n)
+	}
+}
+
+func TestInsert(t *testing.T) {
+	o := Some(1)
+	p := o.Insert(5)
+	*p = 6
+	if o.Unwrap() != 6 {
+		t.Fatalf("write through Insert pointer: o = %v; want Some(6)", o)
+	}
+}
+
+func TestGetOrInsert(t *testing.T) {
+	var o Option[int]
+	*o.GetOrInsert(1) += 10
+	if o.Unwrap() != 11 {
+		t.Fatalf("GetOrInsert on None: o = %v; want Some(11)", o)
+	}
+	if *o.GetOrInsert(99) != 11 {
+		t.Fatal("GetOrInsert on Some should keep the existing value")
+	}
+
+	calls := 0
+	var m Option[map[string]int]
+	gen := func() map[string]int { calls++; return map[string]int{} }
+	(*m.GetOrInsertWith(gen))["a"] = 1
+	(*m.GetOrInsertWith(gen))["b"] = 2
+	if calls != 1 || len(m.Unwrap()) != 2 {
+		t.Fatalf("GetOrInsertWith: calls=%d, len=%d; want 1, 2", calls, len(m.Unwrap()))
+	}
+}
+
+func TestClear(t *testing.T) {
+	o := Some(42)
+	o.Clear()
+	if o.IsSome() {
+		t.Fatal("Clear should leave None")
+	}
+}
+
+func TestAsMutAndPtr(t *testing.T) {
+	o := Some(1)
+	AsMut(&o).Tap(func(p *int) { *p = 2 })
+	if o.Unwrap() != 2 {
+		t.Fatalf("AsMut write: o = %v; want Some(2)", o)
+	}
+	if p := o.Ptr(); p == nil {
+		t.Fatal("Ptr on Some should be non-nil")
+	} else {
+		*p++
+	}
+	if o.Unwrap() != 3 {
+		t.Fatalf("Ptr write: o = %v; want Some(3)", o)
+	}
+	var n Option[int]
+	if AsMut(&n).IsSome() || n.Ptr() != nil {
+		t.Fatal("AsMut/Ptr on None should be None/nil")
+	}
+}
+
+func TestAggregates(t *testing.T) {
+	opts := []Option[int]{Some(3), None[int](), Some(1), Some(2)}
+	all := []Option[int]{Some(3), Some(1), Some(2)}
+	nones := []Option[int]{None[int](), None[int]()}
+
+	if v := Sum(opts, SkipNone); v.Unwrap() != 6 {
+		t.Fatalf("Sum(SkipNone) = %v; want Some(6)", v)
+	}
+	if Sum(opts, PropagateNone).IsSome() {
+		t.Fatal("Sum(PropagateNone) with a None should be None")
+	}
+	if v := Sum(all, PropagateNone); v.Unwrap() != 6 {
+		t.Fatalf("Sum(PropagateNone) = %v; want Some(6)", v)
+	}
+	if v := Sum(nones, SkipNone); v.Unwrap() != 0 {
+		t.Fatalf("Sum of all None = %v; want Some(0)", v)
+	}
+	if v := Product(opts, SkipNone); v.Unwrap() != 6 {
+		t.Fatalf("Product(SkipNone) = %v; want Some(6)", v)
+	}
+	if v := Product(nones, SkipNone); v.Unwrap() != 1 {
+		t.Fatalf("Product of all None = %v; want Some(1)", v)
+	}
+	if Product(opts, PropagateNone).IsSome() {
+		t.Fatal("Product(PropagateNone) with a None should be None")
+	}
+	if v := Min(opts, SkipNone); v.Unwrap() != 1 {
+		t.Fatalf("Min(SkipNone) = %v; want Some(1)", v)
+	}
+	if v := Max(opts, SkipNone); v.Unwrap() != 3 {
+		t.Fatalf("Max(SkipNone) = %v; want Some(3)", v)
+	}
+	if Min(opts, PropagateNone).IsSome() || Max(opts, PropagateNone).IsSome() {
+		t.Fatal("Min/Max(PropagateNone) with a None should be None")
+	}
+	if Min(nones, SkipNone).IsSome() || Max[int](nil, SkipNone).IsSome() {
+		t.Fatal("Min/Max with no Some values should be None")
+	}
+	if v := Avg(opts, SkipNone); v.Unwrap() != 2 {
+		t.Fatalf("Avg(SkipNone) = %v; want Some(2)", v)
+	}
+	if Avg(opts, PropagateNone).IsSome() || Avg(nones, SkipNone).IsSome() {
+		t.Fatal("Avg should be None with PropagateNone and a None, or with no Some values")
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	if Add(Some(1), Some(2)).Unwrap() != 3 || Sub(Some(5), Some(2)).Unwrap() != 3 ||
+		Mul(Some(2), Some(3)).Unwrap() != 6 || Div(Some(6), Some(3)).Unwrap() != 2 {
+		t.Fatal("lifted arithmetic on Some values mismatch")
+	}
+	if Add(Some(1), None[int]()).IsSome() || Sub(None[int](), Some(1)).IsSome() ||
+		Mul(None[int](), None[int]()).IsSome() || Div(None[int](), Some(1)).IsSome() {
+		t.Fatal("lifted arithmetic with None should be None")
+	}
+	if Div(Some(6), Some(0)).IsSome() || Div(Some(1.0), Some(0.0)).IsSome() {
+		t.Fatal("Div by zero should be None")
+	}
+}
+
+func TestFoldReduce(t *testing.T) {
+	opts := []Option[int]{Some(1), None[int](), Some(2)}
+	s := Fold(opts, "", func(acc string, x int) string { return acc + strconv.Itoa(x) })
+	if s != "12" {
+		t.Fatalf("Fold = %q; want \"12\"", s)
+	}
+	if v := Reduce(opts, func(a, b int) int { return a*10 + b }); v.Unwrap() != 12 {
+		t.Fatalf("Reduce = %v; want Some(12)", v)
+	}
+	if Reduce([]Option[int]{None[int]()}, func(a, b int) int { return a + b }).IsSome() {
+		t.Fatal("Reduce with no Some values should be None")
+	}
+}
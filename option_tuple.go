@@ -0,0 +1,412 @@
+package gopt
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Triple holds three values; used by Zip3.
+//
+// Example:
+//
+//	t := Zip3(Some(1), Some("a"), Some(true)).Unwrap()
+//	t.First, t.Second, t.Third  // 1, "a", true
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Quad holds four values; used by Zip4.
+//
+// Example:
+//
+//	q := Zip4(Some(1), Some(2), Some(3), Some(4)).Unwrap()
+type Quad[A, B, C, D any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+}
+
+// Quint holds five values; used by Zip5.
+//
+// Example:
+//
+//	q := Zip5(Some(1), Some(2), Some(3), Some(4), Some(5)).Unwrap()
+type Quint[A, B, C, D, E any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+	Fifth  E
+}
+
+// Zip3 returns Some(Triple{...}) if a, b and c are all Some, otherwise None.
+//
+// Example:
+//
+//	o := Zip3(Some(1), Some("a"), Some(true))  // Some(Triple{1, "a", true})
+func Zip3[A, B, C any](a Option[A], b Option[B], c Option[C]) Option[Triple[A, B, C]] {
+	if !a.ok || !b.ok || !c.ok {
+		return Option[Triple[A, B, C]]{src: firstSource(a.source(), b.source(), c.source())}
+	}
+	return Some(Triple[A, B, C]{a.value, b.value, c.value})
+}
+
+// Zip4 returns Some(Quad{...}) if all four options are Some, otherwise None.
+//
+// Example:
+//
+//	o := Zip4(Some(1), Some(2), Some(3), Some(4))  // Some(Quad{1, 2, 3, 4})
+func Zip4[A, B, C, D any](a Option[A], b Option[B], c Option[C], d Option[D]) Option[Quad[A, B, C, D]] {
+	if !a.ok || !b.ok || !c.ok || !d.ok {
+		return Option[Quad[A, B, C, D]]{src: firstSource(a.source(), b.source(), c.source(), d.source())}
+	}
+	return Some(Quad[A, B, C, D]{a.value, b.value, c.value, d.value})
+}
+
+// Zip5 returns Some(Quint{...}) if all five options are Some, otherwise None.
+//
+// Example:
+//
+//	o := Zip5(Some(1), Some(2), Some(3), Some(4), Some(5))  // Some(Quint{1, 2, 3, 4, 5})
+func Zip5[A, B, C, D, E any](a Option[A], b Option[B], c Option[C], d Option[D], e Option[E]) Option[Quint[A, B, C, D, E]] {
+	if !a.ok || !b.ok || !c.ok || !d.ok || !e.ok {
+		return Option[Quint[A, B, C, D, E]]{src: firstSource(a.source(), b.source(), c.source(), d.source(), e.source())}
+	}
+	return Some(Quint[A, B, C, D, E]{a.value, b.value, c.value, d.value, e.value})
+}
+
+// ZipWith returns Some(fn(a, b)) if both a and b are Some, otherwise None.
+//
+// Example:
+//
+//	o := ZipWith(Some(2), Some(3), func(x, y int) int { return x * y })  // Some(6)
+func ZipWith[A, B, R any](a Option[A], b Option[B], fn func(A, B) R) Option[R] {
+	if !a.ok || !b.ok {
+		return Option[R]{src: firstSource(a.source(), b.source())}
+	}
+	return Some(fn(a.value, b.value))
+}
+
+// Unzip splits Some(Pair{a, b}) into (Some(a), Some(b)), and None into (None, None).
+//
+// Example:
+//
+//	a, b := Unzip(Zip(Some(1), Some("a")))  // Some(1), Some("a")
+func Unzip[A, B any](o Option[Pair[A, B]]) (Option[A], Option[B]) {
+	if !o.ok {
+		return propagate[A](o), propagate[B](o)
+	}
+	return Some(o.value.First), Some(o.value.Second)
+}
+
+// Map2 returns Some(fn(a, b)) if both options are Some, otherwise None. It is ZipWith under
+// the name used by the other MapN helpers.
+//
+// Example:
+//
+//	o := Map2(Some(1), Some(2), func(x, y int) int { return x + y })  // Some(3)
+func Map2[A, B, R any](a Option[A], b Option[B], fn func(A, B) R) Option[R] {
+	return ZipWith(a, b, fn)
+}
+
+// Map3 returns Some(fn(a, b, c)) if all options are Some, otherwise None.
+//
+// Example:
+//
+//	o := Map3(name, age, email, func(n string, a int, e string) User { return User{n, a, e} })
+func Map3[A, B, C, R any](a Option[A], b Option[B], c Option[C], fn func(A, B, C) R) Option[R] {
+	if !a.ok || !b.ok || !c.ok {
+		return Option[R]{src: firstSource(a.source(), b.source(), c.source())}
+	}
+	return Some(fn(a.value, b.value, c.value))
+}
+
+// Map4 returns Some(fn(a, b, c, d)) if all options are Some, otherwise None.
+//
+// Example:
+//
+//	o := Map4(Some(1), Some(2), Some(3), Some(4), func(a, b, c, d int) int { return a + b + c + d })  // Some(10)
+func Map4[A, B, C, D, R any](a Option[A], b Option[B], c Option[C], d Option[D], fn func(A, B, C, D) R) Option[R] {
+	if !a.ok || !b.ok || !c.ok || !d.ok {
+		return Option[R]{src: firstSource(a.source(), b.source(), c.source(), d.source())}
+	}
+	return Some(fn(a.value, b.value, c.value, d.value))
+}
+
+// Map5 returns Some(fn(a, b, c, d, e)) if all options are Some, otherwise None.
+//
+// Example:
+//
+//	form := Map5(name, email, age, city, zip, NewSignup)
+func Map5[A, B, C, D, E, R any](a Option[A], b Option[B], c Option[C], d Option[D], e Option[E], fn func(A, B, C, D, E) R) Option[R] {
+	if !a.ok || !b.ok || !c.ok || !d.ok || !e.ok {
+		return Option[R]{src: firstSource(a.source(), b.source(), c.source(), d.source(), e.source())}
+	}
+	return Some(fn(a.value, b.value, c.value, d.value, e.value))
+}
+
+// TupleFormat selects how MarshalTuple encodes Pair, Triple, Quad and Quint.
+type TupleFormat int
+
+const (
+	// TupleObject encodes tuples as objects keyed by field name: {"First":1,"Second":"a"}.
+	// This is what MarshalJSON produces and matches the encoding of a plain struct.
+	TupleObject TupleFormat = iota
+	// TupleArray encodes tuples as arrays: [1,"a"].
+	TupleArray
+)
+
+// Tuple is implemented by Pair, Triple, Quad and Quint.
+type Tuple interface {
+	tupleValues() []any
+}
+
+func (p Pair[A, B]) tupleValues() []any {
+	return []any{p.First, p.Second}
+}
+
+func (t Triple[A, B, C]) tupleValues() []any {
+	return []any{t.First, t.Second, t.Third}
+}
+
+func (q Quad[A, B, C, D]) tupleValues() []any {
+	return []any{q.First, q.Second, q.Third, q.Fourth}
+}
+
+func (q Quint[A, B, C, D, E]) tupleValues() []any {
+	return []any{q.First, q.Second, q.Third, q.Fourth, q.Fifth}
+}
+
+// MarshalTuple encodes t as JSON in format f. The tuple types' UnmarshalJSON accepts
+// both formats, so the result decodes back with json.Unmarshal.
+//
+// Example:
+//
+//	b, _ := MarshalTuple(Pair[int, string]{1, "a"}, TupleArray)  // []byte(`[1,"a"]`)
+func MarshalTuple(t Tuple, f TupleFormat) ([]byte, error) {
+	vals := t.tupleValues()
+	if f == TupleArray {
+		return json.Marshal(vals)
+	}
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, v := range vals {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString(`"` + tupleFields[i] + `":`)
+		buf.Write(b)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+var tupleFields = [...]string{"First", "Second", "Third", "Fourth", "Fifth"}
+
+// unmarshalTuple decodes an array or object into ptrs, in field order. Object keys match
+// field names exactly first, then case-insensitively like encoding/json; among several
+// case-insensitive matches the smallest key wins. Missing keys leave fields unchanged.
+func unmarshalTuple(data []byte, ptrs ...any) error {
+	trimmed := bytes.TrimSpace(data)
+	if bytes.Equal(trimmed, []byte("null")) {
+		return nil
+	}
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		var raw []json.RawMessage
+		if err := json.Unmarshal(trimmed, &raw); err != nil {
+			return err
+		}
+		if len(raw) != len(ptrs) {
+			return fmt.Errorf("gopt: tuple has %d elements, JSON array has %d", len(ptrs), len(raw))
+		}
+		for i, p := range ptrs {
+			if err := json.Unmarshal(raw[i], p); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(trimmed, &raw); err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(raw))
+	for k := range raw {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for i, p := range ptrs {
+		v, ok := raw[tupleFields[i]]
+		for _, k := range keys {
+			if ok {
+				break
+			}
+			if strings.EqualFold(k, tupleFields[i]) {
+				v, ok = raw[k], true
+			}
+		}
+		if !ok {
+			continue
+		}
+		if err := json.Unmarshal(v, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements encoding/json.Marshaler in the TupleObject format; see MarshalTuple.
+//
+// Example:
+//
+//	b, _ := json.Marshal(Pair[int, string]{1, "a"})  // {"First":1,"Second":"a"}
+func (p Pair[A, B]) MarshalJSON() ([]byte, error) {
+	return MarshalTuple(p, TupleObject)
+}
+
+// UnmarshalJSON implements encoding/json.Unmarshaler. Both the array and object forms are accepted.
+//
+// Example:
+//
+//	var p Pair[int, string]
+//	json.Unmarshal([]byte(`[1,"a"]`), &p)  // p = Pair{1, "a"}
+func (p *Pair[A, B]) UnmarshalJSON(data []byte) error {
+	return unmarshalTuple(data, &p.First, &p.Second)
+}
+
+// MarshalJSON implements encoding/json.Marshaler in the TupleObject format; see MarshalTuple.
+//
+// Example:
+//
+//	b, _ := json.Marshal(Triple[int, int, int]{1, 2, 3})  // {"First":1,"Second":2,"Third":3}
+func (t Triple[A, B, C]) MarshalJSON() ([]byte, error) {
+	return MarshalTuple(t, TupleObject)
+}
+
+// UnmarshalJSON implements encoding/json.Unmarshaler. Both the array and object forms are accepted.
+//
+// Example:
+//
+//	var t Triple[int, int, int]
+//	json.Unmarshal([]byte(`[1,2,3]`), &t)
+func (t *Triple[A, B, C]) UnmarshalJSON(data []byte) error {
+	return unmarshalTuple(data, &t.First, &t.Second, &t.Third)
+}
+
+// MarshalJSON implements encoding/json.Marshaler in the TupleObject format; see MarshalTuple.
+//
+// Example:
+//
+//	b, _ := json.Marshal(Quad[int, int, int, int]{1, 2, 3, 4})
+func (q Quad[A, B, C, D]) MarshalJSON() ([]byte, error) {
+	return MarshalTuple(q, TupleObject)
+}
+
+// UnmarshalJSON implements encoding/json.Unmarshaler. Both the array and object forms are accepted.
+//
+// Example:
+//
+//	var q Quad[int, int, int, int]
+//	json.Unmarshal([]byte(`[1,2,3,4]`), &q)
+func (q *Quad[A, B, C, D]) UnmarshalJSON(data []byte) error {
+	return unmarshalTuple(data, &q.First, &q.Second, &q.Third, &q.Fourth)
+}
+
+// MarshalJSON implements encoding/json.Marshaler in the TupleObject format; see MarshalTuple.
+//
+// Example:
+//
+//	b, _ := json.Marshal(Quint[int, int, int, int, int]{1, 2, 3, 4, 5})
+func (q Quint[A, B, C, D, E]) MarshalJSON() ([]byte, error) {
+	return MarshalTuple(q, TupleObject)
+}
+
+// UnmarshalJSON implements encoding/json.Unmarshaler. Both the array and object forms are accepted.
+//
+// Example:
+//
+//	var q Quint[int, int, int, int, int]
+//	json.Unmarshal([]byte(`[1,2,3,4,5]`), &q)
+func (q *Quint[A, B, C, D, E]) UnmarshalJSON(data []byte) error {
+	return unmarshalTuple(data, &q.First, &q.Second, &q.Third, &q.Fourth, &q.Fifth)
+}
+
+// PairArray is a Pair that encodes as a JSON array. Use it for struct fields that should be
+// arrays; convert with PairArray[A, B](p) and Pair[A, B](pa). Decoding accepts both forms.
+//
+// Example:
+//
+//	type Point struct{ XY PairArray[int, int] }
+//	b, _ := json.Marshal(Point{XY: PairArray[int, int]{1, 2}})  // {"XY":[1,2]}
+type PairArray[A, B any] Pair[A, B]
+
+// MarshalJSON implements encoding/json.Marshaler in the TupleArray format.
+func (p PairArray[A, B]) MarshalJSON() ([]byte, error) {
+	return MarshalTuple(Pair[A, B](p), TupleArray)
+}
+
+// UnmarshalJSON implements encoding/json.Unmarshaler. Both the array and object forms are accepted.
+func (p *PairArray[A, B]) UnmarshalJSON(data []byte) error {
+	return unmarshalTuple(data, &p.First, &p.Second)
+}
+
+// TripleArray is a Triple that encodes as a JSON array; see PairArray.
+//
+// Example:
+//
+//	rgb := TripleArray[uint8, uint8, uint8]{255, 128, 0}  // [255,128,0]
+type TripleArray[A, B, C any] Triple[A, B, C]
+
+// MarshalJSON implements encoding/json.Marshaler in the TupleArray format.
+func (t TripleArray[A, B, C]) MarshalJSON() ([]byte, error) {
+	return MarshalTuple(Triple[A, B, C](t), TupleArray)
+}
+
+// UnmarshalJSON implements encoding/json.Unmarshaler. Both the array and object forms are accepted.
+func (t *TripleArray[A, B, C]) UnmarshalJSON(data []byte) error {
+	return unmarshalTuple(data, &t.First, &t.Second, &t.Third)
+}
+
+// QuadArray is a Quad that encodes as a JSON array; see PairArray.
+//
+// Example:
+//
+//	box := QuadArray[int, int, int, int]{0, 0, 10, 10}  // [0,0,10,10]
+type QuadArray[A, B, C, D any] Quad[A, B, C, D]
+
+// MarshalJSON implements encoding/json.Marshaler in the TupleArray format.
+func (q QuadArray[A, B, C, D]) MarshalJSON() ([]byte, error) {
+	return MarshalTuple(Quad[A, B, C, D](q), TupleArray)
+}
+
+// UnmarshalJSON implements encoding/json.Unmarshaler. Both the array and object forms are accepted.
+func (q *QuadArray[A, B, C, D]) UnmarshalJSON(data []byte) error {
+	return unmarshalTuple(data, &q.First, &q.Second, &q.Third, &q.Fourth)
+}
+
+// QuintArray is a Quint that encodes as a JSON array; see PairArray.
+//
+// Example:
+//
+//	q := QuintArray[int, int, int, int, int]{1, 2, 3, 4, 5}  // [1,2,3,4,5]
+type QuintArray[A, B, C, D, E any] Quint[A, B, C, D, E]
+
+// MarshalJSON implements encoding/json.Marshaler in the TupleArray format.
+func (q QuintArray[A, B, C, D, E]) MarshalJSON() ([]byte, error) {
+	return MarshalTuple(Quint[A, B, C, D, E](q), TupleArray)
+}
+
+// UnmarshalJSON implements encoding/json.Unmarshaler. Both the array and object forms are accepted.
+func (q *QuintArray[A, B, C, D, E]) UnmarshalJSON(data []byte) error {
+	return unmarshalTuple(data, &q.First, &q.Second, &q.Third, &q.Fourth, &q.Fifth)
+}
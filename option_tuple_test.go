@@ -0,0 +1,135 @@
+package gopt
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+)
+
+func TestZipN(t *testing.T) {
+	if v := Zip3(Some(1), Some("a"), Some(true)); v.Unwrap() != (Triple[int, string, bool]{1, "a", true}) {
+		t.Fatalf("Zip3 = %v; want Some(Triple{1 a true})", v)
+	}
+	if Zip3(Some(1), None[string](), Some(true)).IsSome() {
+		t.Fatal("Zip3 with a None should be None")
+	}
+	if v := Zip4(Some(1), Some(2), Some(3), Some(4)); v.Unwrap() != (Quad[int, int, int, int]{1, 2, 3, 4}) {
+		t.Fatalf("Zip4 = %v; want Some(Quad{1 2 3 4})", v)
+	}
+	if Zip4(Some(1), Some(2), Some(3), None[int]()).IsSome() {
+		t.Fatal("Zip4 with a None should be None")
+	}
+	if v := Zip5(Some(1), Some(2), Some(3), Some(4), Some(5)); v.Unwrap() != (Quint[int, int, int, int, int]{1, 2, 3, 4, 5}) {
+		t.Fatalf("Zip5 = %v; want Some(Quint{1 2 3 4 5})", v)
+	}
+	if Zip5(None[int](), Some(2), Some(3), Some(4), Some(5)).IsSome() {
+		t.Fatal("Zip5 with a None should be None")
+	}
+}
+
+func TestZipWithUnzip(t *testing.T) {
+	mul := func(x, y int) int { return x * y }
+	if ZipWith(Some(2), Some(3), mul).Unwrap() != 6 || ZipWith(Some(2), None[int](), mul).IsSome() {
+		t.Fatal("ZipWith mismatch")
+	}
+	a, b := Unzip(Zip(Some(1), Some("a")))
+	if a.Unwrap() != 1 || b.Unwrap() != "a" {
+		t.Fatalf("Unzip = %v, %v; want Some(1), Some(\"a\")", a, b)
+	}
+	a, b = Unzip(None[Pair[int, string]]())
+	if a.IsSome() || b.IsSome() {
+		t.Fatal("Unzip(None) should be (None, None)")
+	}
+}
+
+func TestMapN(t *testing.T) {
+	if Map2(Some(1), Some(2), func(a, b int) int { return a + b }).Unwrap() != 3 {
+		t.Fatal("Map2 mismatch")
+	}
+	join := func(a string, b int, c bool) string { return a + strconv.Itoa(b) + strconv.FormatBool(c) }
+	if Map3(Some("x"), Some(1), Some(true), join).Unwrap() != "x1true" || Map3(Some("x"), None[int](), Some(true), join).IsSome() {
+		t.Fatal("Map3 mismatch")
+	}
+	sum4 := func(a, b, c, d int) int { return a + b + c + d }
+	if Map4(Some(1), Some(2), Some(3), Some(4), sum4).Unwrap() != 10 || Map4(Some(1), Some(2), None[int](), Some(4), sum4).IsSome() {
+		t.Fatal("Map4 mismatch")
+	}
+	sum5 := func(a, b, c, d, e int) int { return a + b + c + d + e }
+	if Map5(Some(1), Some(2), Some(3), Some(4), Some(5), sum5).Unwrap() != 15 || Map5(Some(1), Some(2), Some(3), Some(4), None[int](), sum5).IsSome() {
+		t.Fatal("Map5 mismatch")
+	}
+}
+
+func TestTupleJSON(t *testing.T) {
+	p := Pair[int, Option[string]]{1, Some("a")}
+	b, err := json.Marshal(p)
+	if err != nil || string(b) != `{"First":1,"Second":"a"}` {
+		t.Fatalf("json.Marshal(Pair) object = %s, %v", b, err)
+	}
+	b, err = json.Marshal(Quint[int, int, int, int, int]{1, 2, 3, 4, 5})
+	if err != nil || string(b) != `{"First":1,"Second":2,"Third":3,"Fourth":4,"Fifth":5}` {
+		t.Fatalf("json.Marshal(Quint) object = %s, %v", b, err)
+	}
+
+	b, err = MarshalTuple(Triple[int, string, Option[int]]{1, "a", None[int]()}, TupleArray)
+	if err != nil || string(b) != `[1,"a",null]` {
+		t.Fatalf("json.Marshal(Triple) array = %s, %v", b, err)
+	}
+	b, err = MarshalTuple(Quad[int, int, int, int]{1, 2, 3, 4}, TupleArray)
+	if err != nil || string(b) != `[1,2,3,4]` {
+		t.Fatalf("json.Marshal(Quad) array = %s, %v", b, err)
+	}
+
+	var q Pair[int, Option[string]]
+	if err := json.Unmarshal([]byte(`[2,null]`), &q); err != nil || q.First != 2 || q.Second.IsSome() {
+		t.Fatalf("json.Unmarshal(array) = %+v, %v", q, err)
+	}
+	if err := json.Unmarshal([]byte(`{"first":3,"second":"b"}`), &q); err != nil || q.First != 3 || q.Second.Unwrap() != "b" {
+		t.Fatalf("json.Unmarshal(object) = %+v, %v", q, err)
+	}
+	for i := 0; i < 20; i++ {
+		if err := json.Unmarshal([]byte(`{"FIRST":1,"first":2,"First":3,"Second":"c"}`), &q); err != nil || q.First != 3 {
+			t.Fatalf("json.Unmarshal(exact key) = %+v, %v; want First 3", q, err)
+		}
+		if err := json.Unmarshal([]byte(`{"first":4,"FIRST":5}`), &q); err != nil || q.First != 5 {
+			t.Fatalf("json.Unmarshal(case-folded keys) = %+v, %v; want First 5", q, err)
+		}
+	}
+	if err := json.Unmarshal([]byte(`[1]`), &q); err == nil {
+		t.Fatal("json.Unmarshal with wrong array length should return error")
+	}
+	var tr Triple[int, int, int]
+	if err := json.Unmarshal([]byte(`[1,2,3]`), &tr); err != nil || tr != (Triple[int, int, int]{1, 2, 3}) {
+		t.Fatalf("json.Unmarshal(Triple) = %+v, %v", tr, err)
+	}
+}
+
+func TestTupleArrayFields(t *testing.T) {
+	type response struct {
+		Range  PairArray[int, int]                 `json:"range"`
+		Color  TripleArray[int, int, int]          `json:"color"`
+		Box    QuadArray[int, int, int, int]       `json:"box"`
+		Digits QuintArray[int, int, int, int, int] `json:"digits"`
+		Label  Pair[string, int]                   `json:"label"`
+	}
+	r := response{
+		Range:  PairArray[int, int](Pair[int, int]{1, 2}),
+		Color:  TripleArray[int, int, int]{255, 128, 0},
+		Box:    QuadArray[int, int, int, int]{0, 0, 10, 10},
+		Digits: QuintArray[int, int, int, int, int]{1, 2, 3, 4, 5},
+		Label:  Pair[string, int]{"a", 7},
+	}
+	b, err := json.Marshal(r)
+	want := `{"range":[1,2],"color":[255,128,0],"box":[0,0,10,10],"digits":[1,2,3,4,5],"label":{"First":"a","Second":7}}`
+	if err != nil || string(b) != want {
+		t.Fatalf("json.Marshal(response) = %s, %v; want %s", b, err, want)
+	}
+	var back response
+	if err := json.Unmarshal(b, &back); err != nil || back != r {
+		t.Fatalf("json.Unmarshal(response) = %+v, %v; want %+v", back, err, r)
+	}
+	var p PairArray[int, int]
+	if err := json.Unmarshal([]byte(`{"First":3,"Second":4}`), &p); err != nil || Pair[int, int](p) != (Pair[int, int]{3, 4}) {
+		t.Fatalf("json.Unmarshal(object into PairArray) = %+v, %v", p, err)
+	}
+}
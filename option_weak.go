@@ -0,0 +1,133 @@
+//go:build go1.24
+
+package gopt
+
+import "weak"
+
+// Weak is a weak reference to a *T: it does not keep the object alive, and Value becomes
+// None once the garbage collector has reclaimed it. The zero value is always None.
+// It requires Go 1.24.
+//
+// Example:
+//
+//	w := MakeWeak(img)
+//	if p, ok := w.Value().Get(); ok { draw(p) }
+type Weak[T any] struct {
+	p weak.Pointer[T]
+}
+
+// MakeWeak returns a weak reference to p. A nil p gives a Weak whose Value is None.
+//
+// Example:
+//
+//	w := MakeWeak(&buf)
+func MakeWeak[T any](p *T) Weak[T] {
+	return Weak[T]{p: weak.Make(p)}
+}
+
+// Value returns Some(pointer) while the object is alive, and None once it has been collected.
+//
+// Example:
+//
+//	w.Value()  // None after the last strong reference is gone and a GC has run
+func (w Weak[T]) Value() Option[*T] {
+	p := w.p.Value()
+	return FromTuple(p, p != nil)
+}
+
+// WeakCache is a Cache that holds its Some values through weak references, so cached objects
+// can still be collected once nothing else uses them; a collected entry reads as a miss.
+// None entries are cached as in Cache. Create with NewWeakCache. It requires Go 1.24.
+//
+// Example:
+//
+//	images := NewWeakCache[string, Image](CacheOptions{NoneTTL: time.Minute})
+//	img := images.GetOrLoad(path, func() Option[*Image] { return decode(path) })
+type WeakCache[K comparable, V any] struct {
+	c *Cache[K, Weak[V]]
+}
+
+// NewWeakCache returns an empty WeakCache configured by opts.
+//
+// Example:
+//
+//	c := NewWeakCache[string, Image](CacheOptions{Capacity: 1000})
+func NewWeakCache[K comparable, V any](opts CacheOptions) *WeakCache[K, V] {
+	return &WeakCache[K, V]{c: NewCache[K, Weak[V]](opts)}
+}
+
+// Get is like Cache's Get: None on a miss (including a collected value), Some(None) for a
+// cached None, and Some(Some(pointer)) for a live value.
+//
+// Example:
+//
+//	if img, ok := Flatten(c.Get(path)).Get(); ok { draw(img) }
+func (w *WeakCache[K, V]) Get(key K) Option[Option[*V]] {
+	hit := w.c.Get(key)
+	if !hit.ok {
+		return None[Option[*V]]()
+	}
+	if !hit.value.ok {
+		return Some(None[*V]())
+	}
+	p := hit.value.value.Value()
+	if !p.ok {
+		w.deleteCollected(key, hit.value.value)
+		return None[Option[*V]]()
+	}
+	return Some(p)
+}
+
+// deleteCollected removes key only if it still holds dead, so a Set or load that replaced the
+// collected entry in the meantime is kept.
+func (w *WeakCache[K, V]) deleteCollected(key K, dead Weak[V]) {
+	w.c.deleteIf(key, func(o Option[Weak[V]]) bool {
+		return o.ok && o.value == dead
+	})
+}
+
+// Set caches o for key; a Some is held weakly.
+//
+// Example:
+//
+//	c.Set(path, Some(img))
+func (w *WeakCache[K, V]) Set(key K, o Option[*V]) {
+	w.c.Set(key, Map(o, MakeWeak[V]))
+}
+
+// Delete removes key from the cache.
+//
+// Example:
+//
+//	c.Delete(path)
+func (w *WeakCache[K, V]) Delete(key K) {
+	w.c.Delete(key)
+}
+
+// GetOrLoad is like Cache's GetOrLoad. A value that has been collected is loaded again.
+//
+// Example:
+//
+//	img := c.GetOrLoad(path, func() Option[*Image] { return decode(path) })
+func (w *WeakCache[K, V]) GetOrLoad(key K, loader func() Option[*V]) Option[*V] {
+	if hit := w.Get(key); hit.ok {
+		return hit.value
+	}
+	var strong Option[*V] // keeps a loaded value alive until it is returned
+	o := w.c.GetOrLoad(key, func() Option[Weak[V]] {
+		strong = loader()
+		return Map(strong, MakeWeak[V])
+	})
+	if strong.ok {
+		return strong
+	}
+	if !o.ok {
+		return None[*V]()
+	}
+	if p := o.value.Value(); p.ok {
+		return p
+	}
+	// Another caller's load was collected before we read it; load again.
+	w.deleteCollected(key, o.value)
+	return w.GetOrLoad(key, loader)
+}
@@ -0,0 +1,92 @@
+//go:build go1.24
+
+package gopt
+
+import (
+	"runtime"
+	"testing"
+)
+
+func TestWeak(t *testing.T) {
+	if (Weak[int]{}).Value().IsSome() || MakeWeak[int](nil).Value().IsSome() {
+		t.Fatal("zero Weak and MakeWeak(nil) should be None")
+	}
+	p := new([64]byte)
+	w := MakeWeak(p)
+	if v := w.Value(); v.UnwrapOr(nil) != p {
+		t.Fatalf("Value() = %v; want Some(p)", v)
+	}
+	runtime.KeepAlive(p)
+	p = nil
+	runtime.GC()
+	if w.Value().IsSome() {
+		t.Fatal("Value() should be None after the object is collected")
+	}
+}
+
+func TestWeakCache(t *testing.T) {
+	c := NewWeakCache[string, [64]byte](CacheOptions{})
+	loads := 0
+	loader := func() Option[*[64]byte] { loads++; return Some(new([64]byte)) }
+	p := c.GetOrLoad("k", loader)
+	if p.IsNone() || loads != 1 {
+		t.Fatalf("GetOrLoad = %v after %d loads; want Some after 1", p, loads)
+	}
+	if q := c.GetOrLoad("k", loader); q.UnwrapOr(nil) != p.Unwrap() || loads != 1 {
+		t.Fatal("a live value should be a hit")
+	}
+	runtime.KeepAlive(p)
+	p = None[*[64]byte]()
+	runtime.GC()
+	if c.Get("k").IsSome() {
+		t.Fatal("a collected value should be a miss")
+	}
+	if c.GetOrLoad("k", loader).IsNone() || loads != 2 {
+		t.Fatal("a collected value should be loaded again")
+	}
+
+	c.Set("none", None[*[64]byte]())
+	if v := c.Get("none"); !v.IsSome() || v.Unwrap().IsSome() {
+		t.Fatalf("Get(none) = %v; want Some(None)", v)
+	}
+	c.Delete("none")
+	if c.Get("none").IsSome() {
+		t.Fatal("Delete should remove the entry")
+	}
+}
+
+func TestWeakCacheSetDuringCollectedGet(t *testing.T) {
+	c := NewWeakCache[string, [64]byte](CacheOptions{})
+	c.Set("k", Some(new([64]byte)))
+	runtime.GC()
+	dead := c.c.Get("k").Unwrap().Unwrap()
+	if dead.Value().IsSome() {
+		t.Fatal("the cached value should have been collected")
+	}
+
+	// A Set that lands between Get's read of the dead entry and its removal must survive.
+	fresh := new([64]byte)
+	c.Set("k", Some(fresh))
+	c.deleteCollected("k", dead)
+	if v := Flatten(c.Get("k")); v.UnwrapOr(nil) != fresh {
+		t.Fatalf("Get after Set = %v; want Some(fresh)", v)
+	}
+
+	for i := 0; i < 50; i++ {
+		c.Set("k", Some(new([64]byte)))
+		runtime.GC()
+		p := new([64]byte)
+		done := make(chan struct{})
+		go func() {
+			c.Get("k")
+			close(done)
+		}()
+		c.Set("k", Some(p))
+		<-done
+		if v := Flatten(c.Get("k")); v.UnwrapOr(nil) != p {
+			t.Fatalf("iteration %d: Get after a concurrent Set = %v; want Some(p)", i, v)
+		}
+		runtime.KeepAlive(p)
+	}
+	runtime.KeepAlive(fresh)
+}